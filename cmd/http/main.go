@@ -2,34 +2,80 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"log/slog"
+	"maps"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/auth/jwt"
-	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/config"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/auth/oidc"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/auth/password"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/auth/totp"
+	cfg "github.com/GustavoPaula/go-backup-management-api/internal/adapter/config"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/health"
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/handler"
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/router"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/logger"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/mailer"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/metrics"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/ratelimit"
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres/repository"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/taskqueue"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/tracing"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/service"
 )
 
 func main() {
-	log := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	log := slog.New(tracing.NewLogHandler(slog.NewJSONHandler(os.Stdout, nil)))
 	slog.SetDefault(log)
 
-	config, err := config.New()
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"), "caminho para um arquivo de configuração YAML")
+	printConfig := flag.Bool("print-config", false, "exibe a configuração efetiva (sem segredos) e encerra")
+	flag.Parse()
+
+	config, err := cfg.New(cfg.Options{FilePath: *configFile})
 	if err != nil {
-		slog.Error("Erro ao carregar as variáveis de ambiente", "error", err)
+		var validationErr *cfg.ValidationError
+		if errors.As(err, &validationErr) {
+			for _, problem := range validationErr.Problems {
+				slog.Error("Configuração inválida", "problem", problem)
+			}
+			os.Exit(1)
+		}
+		slog.Error("Erro ao carregar a configuração", "error", err)
 		os.Exit(1)
 	}
 
+	if *printConfig {
+		config.Print(os.Stdout)
+		return
+	}
+
+	log = slog.New(tracing.NewLogHandler(logger.NewHandler(os.Stdout, config.Log)))
+	slog.SetDefault(log)
+
 	ctx := context.Background()
 	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, os.Kill, syscall.SIGTERM, syscall.SIGKILL)
 	defer cancel()
 
+	shutdownTracing, err := tracing.New(ctx, config.Tracing)
+	if err != nil {
+		slog.Error("Erro ao iniciar o tracing", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			slog.Error("Erro ao finalizar o tracing", "error", err)
+		}
+	}()
+
 	db, err := postgres.New(ctx, config.DB)
 	if err != nil {
 		slog.Error("Erro ao iniciar a conexão com o banco de dados", "error", err)
@@ -43,33 +89,196 @@ func main() {
 		os.Exit(1)
 	}
 
-	healthyHandler := handler.NewHealthCheckHandler()
+	workers := health.NewWorkers()
+	healthyHandler := handler.NewHealthCheckHandler(db, db.MigrationChecker(), workers)
 
 	userRepo := repository.NewUserRepository(db)
+	mfaRepo := repository.NewMFARepository(db)
+	userTokenRepo := repository.NewUserTokenRepository(db)
 	deviceRepo := repository.NewDeviceRepository(db)
 	customerRepo := repository.NewCustomerRepository(db)
 	backupPlanRepo := repository.NewBackupPlanRepository(db)
+	retentionRepo := repository.NewRetentionPolicyRepository(db)
+	storageTargetRepo := repository.NewStorageTargetRepository(db)
+	secretRepo := repository.NewSecretRepository(db)
+	agentTokenRepo := repository.NewAgentTokenRepository(db)
+	agentCommandRepo := repository.NewAgentCommandRepository(db)
+	restoreRequestRepo := repository.NewRestoreRequestRepository(db)
+	restorePointRepo := repository.NewRestorePointRepository(db)
+	verificationRepo := repository.NewVerificationRepository(db)
+	sizeAlertRepo := repository.NewSizeAlertRepository(db)
+	apiKeyRepo := repository.NewAPIKeyRepository(db)
+	sessionRepo := repository.NewSessionRepository(db)
+
+	metrics := metrics.New(db, workers, customerRepo, deviceRepo, backupPlanRepo, verificationRepo, restoreRequestRepo, agentCommandRepo)
+
+	var limiter port.RateLimiter = ratelimit.NewMemoryLimiter()
+	if config.Login.RateLimitStore == "postgres" {
+		limiter = repository.NewRateLimitRepository(db)
+	}
+	go workers.Register("ratelimit_sweep", time.Minute).Run(ctx, limiter.Sweep)
+
+	loginPolicy := domain.LoginPolicy{
+		UsernameLimit: domain.RateLimit{
+			Burst:  config.Login.UsernameBurst,
+			Refill: config.Login.UsernameRefill,
+		},
+		LockoutThreshold:         config.Login.LockoutThreshold,
+		LockoutBaseDuration:      config.Login.LockoutBaseDuration,
+		LockoutMaxDuration:       config.Login.LockoutMaxDuration,
+		RequireEmailVerification: config.Account.RequireEmailVerification,
+	}
 
-	userSvc := service.NewUserService(userRepo)
-	authSvc := service.NewAuthService(userRepo, token)
+	totpSvc := totp.New(config.MFA.Issuer)
+
+	var mailSender port.Mailer
+	switch config.Mail.Driver {
+	case "smtp":
+		mailSender = mailer.NewSMTPMailer(config.Mail)
+	case "file":
+		mailSender, err = mailer.NewFileMailer(config.Mail.From, config.Mail.FileDir)
+		if err != nil {
+			slog.Error("Erro ao criar o diretório de e-mails", "error", err)
+			os.Exit(1)
+		}
+	default:
+		mailSender = mailer.NewLogMailer()
+	}
+
+	// A fila é drenada antes de fechar o banco: as tarefas ainda gravam os
+	// tokens antes de enviar o e-mail.
+	mailQueue := taskqueue.New(config.Mail.QueueWorkers, config.Mail.QueueSize)
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), config.HTTP.ShutdownTimeout)
+		defer cancel()
+
+		if err := mailQueue.Shutdown(ctx); err != nil {
+			slog.Error("Falha ao drenar a fila de e-mails", "error", err)
+		}
+	}()
+
+	var breached port.BreachedPasswordChecker
+	if config.Password.BreachedList != "" {
+		bloom, err := password.LoadBloomFilter(config.Password.BreachedList, config.Password.BreachedFalsePositiveRate)
+		if err != nil {
+			slog.Error("Erro ao carregar a lista de senhas vazadas", "error", err)
+			os.Exit(1)
+		}
+		breached = bloom
+	}
+
+	sessionSvc := service.NewSessionService(sessionRepo, token)
+	passwordSvc, err := service.NewPasswordService(userRepo, password.NewHasher(config.Password), breached, sessionSvc, domain.PasswordPolicy{
+		MinLength:     config.Password.MinLength,
+		MaxLength:     config.Password.MaxLength,
+		RequireUpper:  config.Password.RequireUpper,
+		RequireLower:  config.Password.RequireLower,
+		RequireDigit:  config.Password.RequireDigit,
+		RequireSymbol: config.Password.RequireSymbol,
+		HistorySize:   config.Password.HistorySize,
+	})
+	if err != nil {
+		slog.Error("Erro ao iniciar o serviço de senhas", "error", err)
+		os.Exit(1)
+	}
+
+	accountSvc := service.NewAccountService(userRepo, userTokenRepo, passwordSvc, sessionSvc, mailSender, mailQueue, domain.AccountPolicy{
+		BaseURL:                  config.Account.BaseURL,
+		PasswordResetTTL:         config.Account.PasswordResetTTL,
+		EmailVerificationTTL:     config.Account.EmailVerificationTTL,
+		RequireEmailVerification: config.Account.RequireEmailVerification,
+	})
+	userSvc := service.NewUserService(userRepo, passwordSvc, accountSvc, sessionSvc, config.Account.RequireEmailVerification)
+	authSvc := service.NewAuthService(userRepo, mfaRepo, passwordSvc, token, sessionSvc, totpSvc, limiter, loginPolicy)
+	mfaSvc := service.NewMFAService(userRepo, mfaRepo, totpSvc, config.MFA.RecoveryCodes)
+	apiKeySvc := service.NewAPIKeyService(apiKeyRepo, userRepo)
+	var oidcSvc port.OIDCService
+	if config.OIDC.Enabled {
+		provider := oidc.New(config.OIDC)
+
+		roleMapping := make(map[string]domain.UserRole, len(config.OIDC.RoleMapping))
+		for group, role := range config.OIDC.RoleMapping {
+			roleMapping[group] = domain.UserRole(role)
+		}
+
+		oidcSvc = service.NewOIDCService(provider, repository.NewOIDCStateRepository(db), userRepo, passwordSvc, sessionSvc, domain.OIDCPolicy{
+			StateTTL:                 config.OIDC.StateTTL,
+			AutoProvision:            config.OIDC.AutoProvision,
+			DefaultRole:              domain.UserRole(config.OIDC.DefaultRole),
+			RequireEmailVerification: config.Account.RequireEmailVerification,
+			RoleMapping:              roleMapping,
+		})
+	}
 	customerSvc := service.NewCustomerService(customerRepo, deviceRepo)
 	deviceSvc := service.NewDeviceService(deviceRepo, customerRepo)
-	backupPlanSvc := service.NewBackupPlanService(customerRepo, deviceRepo, backupPlanRepo)
+	backupPlanSvc := service.NewBackupPlanService(customerRepo, deviceRepo, backupPlanRepo, retentionRepo, storageTargetRepo, verificationRepo)
+	retentionPolicySvc := service.NewRetentionPolicyService(retentionRepo, customerRepo, backupPlanRepo)
+	secretKeys := maps.Clone(config.Secrets.PreviousKeys)
+	if config.Secrets.MasterKey != nil {
+		secretKeys[config.Secrets.MasterKeyID] = config.Secrets.MasterKey
+	} else {
+		slog.Warn("SECRETS_MASTER_KEY não configurada, credenciais de destinos não poderão ser gravadas")
+	}
+	secretSvc := service.NewSecretService(secretRepo, config.Secrets.MasterKeyID, secretKeys)
+	storageTargetSvc := service.NewStorageTargetService(storageTargetRepo, customerRepo, retentionRepo, secretSvc)
+	agentSvc := service.NewAgentService(agentTokenRepo, deviceRepo, backupPlanRepo, retentionRepo, storageTargetRepo, secretRepo, secretSvc)
+	agentCommandSvc := service.NewAgentCommandService(agentCommandRepo, deviceRepo, backupPlanRepo, config.Agent.CommandTTL, config.Agent.CommandMaxTTL, config.Agent.CommandPollWait)
+	restoreRequestSvc := service.NewRestoreRequestService(restoreRequestRepo, deviceRepo, backupPlanRepo, restorePointRepo)
+	restorePointSvc := service.NewRestorePointService(restorePointRepo, deviceRepo, backupPlanRepo, retentionRepo, sizeAlertRepo)
+	verificationSvc := service.NewVerificationService(verificationRepo, backupPlanRepo, restorePointRepo)
+	go workers.Register("verification_jobs", time.Minute).Run(ctx, verificationSvc.GenerateVerificationJobs)
 
 	userHandler := handler.NewUserHandler(userSvc)
 	authHandler := handler.NewAuthHandler(authSvc)
+	oidcHandler := handler.NewOIDCHandler(oidcSvc)
+	mfaHandler := handler.NewMFAHandler(mfaSvc)
+	accountHandler := handler.NewAccountHandler(accountSvc)
+	passwordHandler := handler.NewPasswordHandler(passwordSvc)
+	apiKeyHandler := handler.NewAPIKeyHandler(apiKeySvc)
+	sessionHandler := handler.NewSessionHandler(sessionSvc)
 	customerHandler := handler.NewCustomerHandler(customerSvc)
 	deviceHandler := handler.NewDeviceHandler(deviceSvc)
 	backupPlanHandler := handler.NewBackupPlanHandler(backupPlanSvc)
+	retentionPolicyHandler := handler.NewRetentionPolicyHandler(retentionPolicySvc)
+	storageTargetHandler := handler.NewStorageTargetHandler(storageTargetSvc)
+	secretHandler := handler.NewSecretHandler(secretSvc)
+	agentHandler := handler.NewAgentHandler(agentSvc)
+	agentCommandHandler := handler.NewAgentCommandHandler(agentCommandSvc)
+	restoreRequestHandler := handler.NewRestoreRequestHandler(restoreRequestSvc)
+	restorePointHandler := handler.NewRestorePointHandler(restorePointSvc)
+	verificationHandler := handler.NewVerificationHandler(verificationSvc)
 
 	router := router.NewRouter(
+		config.HTTP,
 		token,
+		apiKeySvc,
+		sessionSvc,
+		agentSvc,
+		metrics,
+		config.Login,
+		config.MFA,
+		config.OIDC,
+		limiter,
 		*healthyHandler,
 		*userHandler,
 		*authHandler,
+		*oidcHandler,
+		*mfaHandler,
+		*accountHandler,
+		*passwordHandler,
+		*apiKeyHandler,
+		*sessionHandler,
 		*customerHandler,
 		*deviceHandler,
 		*backupPlanHandler,
+		*retentionPolicyHandler,
+		*storageTargetHandler,
+		*secretHandler,
+		*agentHandler,
+		*agentCommandHandler,
+		*restoreRequestHandler,
+		*restorePointHandler,
+		*verificationHandler,
 	)
 
 	if err := router.Serve(ctx, config.HTTP); err != nil {
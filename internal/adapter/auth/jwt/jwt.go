@@ -10,15 +10,23 @@ import (
 	"github.com/google/uuid"
 )
 
+const mfaChallengePurpose = "mfa_challenge"
+
 type JwtToken struct {
-	secretKey []byte
-	duration  time.Duration
+	secretKey            []byte
+	duration             time.Duration
+	mfaChallengeDuration time.Duration
 }
 
 type jwtClaims struct {
 	ID     uuid.UUID       `json:"id"`
 	UserID uuid.UUID       `json:"user_id"`
 	Role   domain.UserRole `json:"role"`
+	MFA    bool            `json:"mfa,omitempty"`
+	// PasswordChange marca tokens que só podem ser usados para trocar a senha.
+	PasswordChange bool `json:"pwd_change,omitempty"`
+	// Purpose diferencia tokens de desafio MFA, que não dão acesso à API.
+	Purpose string `json:"purpose,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -27,33 +35,49 @@ func New(config *config.Token) (port.TokenService, error) {
 		return nil, domain.ErrTokenRequired
 	}
 
-	durationStr := config.Duration
-	duration, err := time.ParseDuration(durationStr)
-	if err != nil {
+	if config.Duration <= 0 || config.MFAChallengeDuration <= 0 {
 		return nil, domain.ErrTokenDuration
 	}
 
 	return &JwtToken{
-		secretKey: []byte(config.JwtSecretKey),
-		duration:  duration,
+		secretKey:            []byte(config.JwtSecretKey),
+		duration:             config.Duration,
+		mfaChallengeDuration: config.MFAChallengeDuration,
 	}, nil
 }
 
-func (j *JwtToken) CreateToken(user *domain.User) (string, error) {
+func (j *JwtToken) CreateToken(user *domain.User, mfa bool) (*domain.IssuedToken, error) {
+	return j.sign(user, mfa, "", j.duration)
+}
+
+func (j *JwtToken) CreateMFAChallenge(user *domain.User) (string, error) {
+	issued, err := j.sign(user, false, mfaChallengePurpose, j.mfaChallengeDuration)
+	if err != nil {
+		return "", err
+	}
+	return issued.Token, nil
+}
+
+func (j *JwtToken) sign(user *domain.User, mfa bool, purpose string, duration time.Duration) (*domain.IssuedToken, error) {
 	if user == nil {
-		return "", domain.ErrDataNotFound
+		return nil, domain.ErrDataNotFound
 	}
 
 	tokenID := uuid.New()
+	now := time.Now()
+	expiresAt := now.Add(duration)
 
 	claims := jwtClaims{
-		ID:     tokenID,
-		UserID: user.ID,
-		Role:   user.Role,
+		ID:             tokenID,
+		UserID:         user.ID,
+		Role:           user.Role,
+		MFA:            mfa,
+		PasswordChange: user.MustChangePassword,
+		Purpose:        purpose,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(j.duration)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "go-backup-management-api",
 			Subject:   user.ID.String(),
 			ID:        tokenID.String(),
@@ -64,13 +88,50 @@ func (j *JwtToken) CreateToken(user *domain.User) (string, error) {
 
 	signedToken, err := token.SignedString(j.secretKey)
 	if err != nil {
-		return "", domain.ErrTokenCreation
+		return nil, domain.ErrTokenCreation
 	}
 
-	return signedToken, nil
+	return &domain.IssuedToken{
+		Token:     signedToken,
+		ID:        tokenID,
+		ExpiresAt: expiresAt,
+	}, nil
 }
 
 func (j *JwtToken) VerifyToken(tokenString string) (*domain.TokenPayload, error) {
+	claims, err := j.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Purpose != "" {
+		return nil, domain.ErrInvalidToken
+	}
+
+	return &domain.TokenPayload{
+		ID:     claims.ID,
+		UserID: claims.UserID,
+		Role:   claims.Role,
+		MFA:    claims.MFA,
+
+		PasswordChangeRequired: claims.PasswordChange,
+	}, nil
+}
+
+func (j *JwtToken) VerifyMFAChallenge(tokenString string) (uuid.UUID, error) {
+	claims, err := j.parse(tokenString)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if claims.Purpose != mfaChallengePurpose {
+		return uuid.Nil, domain.ErrInvalidToken
+	}
+
+	return claims.UserID, nil
+}
+
+func (j *JwtToken) parse(tokenString string) (*jwtClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &jwtClaims{}, func(token *jwt.Token) (any, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, domain.ErrInvalidToken
@@ -91,9 +152,5 @@ func (j *JwtToken) VerifyToken(tokenString string) (*domain.TokenPayload, error)
 		return nil, domain.ErrExpiredToken
 	}
 
-	return &domain.TokenPayload{
-		ID:     claims.ID,
-		UserID: claims.UserID,
-		Role:   claims.Role,
-	}, nil
+	return claims, nil
 }
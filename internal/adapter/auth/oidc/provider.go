@@ -0,0 +1,326 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/config"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// jwksMinRefresh evita que tokens com kid desconhecido forcem uma busca
+	// das chaves a cada requisição.
+	jwksMinRefresh = time.Minute
+	clockLeeway    = time.Minute
+)
+
+// Valores de amr (RFC 8176) que indicam autenticação multifator.
+var mfaMethods = []string{"mfa", "otp", "hwk", "swk", "sms", "fido"}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type Provider struct {
+	config *config.OIDC
+	client *http.Client
+	group  singleflight.Group
+
+	mu          sync.Mutex
+	discovery   *discovery
+	keys        map[string]crypto.PublicKey
+	keysFetched time.Time
+}
+
+// New não consulta o emissor: a descoberta é feita no primeiro login e
+// repetida enquanto falhar, para que o provedor fora do ar não impeça a API
+// de subir nem derrube o login por senha.
+func New(config *config.OIDC) port.OIDCProvider {
+	return &Provider{
+		config: config,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	metadata, err := p.metadata(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.config.ClientID},
+		"redirect_uri":          {p.config.RedirectURL},
+		"scope":                 {strings.Join(p.config.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+
+	separator := "?"
+	if strings.Contains(metadata.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return metadata.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+// metadata devolve o documento de descoberta, buscando-o na primeira vez.
+// Requisições simultâneas compartilham a mesma busca.
+func (p *Provider) metadata(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	metadata := p.discovery
+	p.mu.Unlock()
+
+	if metadata != nil {
+		return metadata, nil
+	}
+
+	value, err, _ := p.group.Do("discovery", func() (any, error) {
+		var metadata discovery
+		wellKnown := strings.TrimSuffix(p.config.IssuerURL, "/") + "/.well-known/openid-configuration"
+		if err := p.getJSON(context.WithoutCancel(ctx), wellKnown, &metadata); err != nil {
+			return nil, fmt.Errorf("descoberta OIDC: %w", err)
+		}
+
+		if metadata.Issuer != p.config.IssuerURL {
+			return nil, fmt.Errorf("descoberta OIDC: issuer %q difere de %q", metadata.Issuer, p.config.IssuerURL)
+		}
+
+		if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+			return nil, fmt.Errorf("descoberta OIDC: documento incompleto")
+		}
+
+		p.mu.Lock()
+		p.discovery = &metadata
+		p.mu.Unlock()
+		return &metadata, nil
+	})
+	if err != nil {
+		return nil, domain.ErrOIDCProvider.Wrap(err)
+	}
+
+	return value.(*discovery), nil
+}
+
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*domain.OIDCIdentity, error) {
+	metadata, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.config.RedirectURL},
+		"code_verifier": {codeVerifier},
+		"client_id":     {p.config.ClientID},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, domain.ErrOIDCProvider.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.config.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, domain.ErrOIDCProvider.Wrap(err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return nil, domain.ErrOIDCProvider.Wrap(err)
+	}
+
+	// Códigos inválidos ou já usados voltam como 400 invalid_grant.
+	if res.StatusCode == http.StatusBadRequest || res.StatusCode == http.StatusUnauthorized {
+		return nil, domain.ErrInvalidOIDCState.Wrap(fmt.Errorf("token endpoint: %s", body))
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, domain.ErrOIDCProvider.Wrap(fmt.Errorf("token endpoint: status %d", res.StatusCode))
+	}
+
+	var tokens struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.Unmarshal(body, &tokens); err != nil || tokens.IDToken == "" {
+		return nil, domain.ErrOIDCProvider.Wrap(fmt.Errorf("resposta sem id_token"))
+	}
+
+	return p.verifyIDToken(ctx, metadata, tokens.IDToken, nonce)
+}
+
+func (p *Provider) verifyIDToken(ctx context.Context, metadata *discovery, rawToken, nonce string) (*domain.OIDCIdentity, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.key(ctx, metadata.JWKSURI, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(clockLeeway),
+	)
+	if err != nil {
+		return nil, domain.ErrInvalidToken.Wrap(err)
+	}
+
+	if claimString(claims, "nonce") != nonce {
+		return nil, domain.ErrInvalidToken.Wrap(fmt.Errorf("nonce divergente"))
+	}
+
+	subject := claimString(claims, "sub")
+	if subject == "" {
+		return nil, domain.ErrInvalidToken.Wrap(fmt.Errorf("id_token sem sub"))
+	}
+
+	identity := &domain.OIDCIdentity{
+		Subject:           subject,
+		Email:             claimString(claims, "email"),
+		EmailVerified:     claimBool(claims, "email_verified"),
+		Name:              claimString(claims, "name"),
+		PreferredUsername: claimString(claims, "preferred_username"),
+		Groups:            claimStrings(claims, p.config.GroupsClaim),
+	}
+
+	for _, method := range claimStrings(claims, "amr") {
+		if slices.Contains(mfaMethods, method) {
+			identity.MFA = true
+			break
+		}
+	}
+
+	return identity, nil
+}
+
+// key devolve a chave do kid informado, recarregando o JWKS quando o kid é
+// desconhecido, o que cobre a rotação de chaves do provedor. A busca é feita
+// fora do lock e compartilhada entre logins simultâneos.
+func (p *Provider) key(ctx context.Context, jwksURI, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	key, ok := p.lookup(kid)
+	fetched := p.keysFetched
+	p.mu.Unlock()
+
+	if ok {
+		return key, nil
+	}
+
+	if time.Since(fetched) < jwksMinRefresh {
+		return nil, fmt.Errorf("chave %q não encontrada no JWKS", kid)
+	}
+
+	_, err, _ := p.group.Do("jwks", func() (any, error) {
+		var set jwks
+		if err := p.getJSON(context.WithoutCancel(ctx), jwksURI, &set); err != nil {
+			return nil, err
+		}
+
+		p.mu.Lock()
+		p.keys = set.publicKeys()
+		p.keysFetched = time.Now()
+		p.mu.Unlock()
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	key, ok = p.lookup(kid)
+	p.mu.Unlock()
+
+	if ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("chave %q não encontrada no JWKS", kid)
+}
+
+// lookup aceita token sem kid apenas quando o provedor publica uma única chave.
+func (p *Provider) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *Provider) getJSON(ctx context.Context, url string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", url, res.StatusCode)
+	}
+
+	return json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(target)
+}
+
+func claimString(claims jwt.MapClaims, name string) string {
+	value, _ := claims[name].(string)
+	return value
+}
+
+// claimBool aceita também "true" em texto, formato usado por alguns provedores.
+func claimBool(claims jwt.MapClaims, name string) bool {
+	switch value := claims[name].(type) {
+	case bool:
+		return value
+	case string:
+		return value == "true"
+	}
+	return false
+}
+
+func claimStrings(claims jwt.MapClaims, name string) []string {
+	switch value := claims[name].(type) {
+	case string:
+		return []string{value}
+	case []any:
+		items := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				items = append(items, s)
+			}
+		}
+		return items
+	}
+	return nil
+}
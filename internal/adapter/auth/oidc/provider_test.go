@@ -0,0 +1,217 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/config"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testClientID = "backup-api"
+	testCode     = "codigo"
+	testVerifier = "verificador"
+	testNonce    = "nonce"
+	testKid      = "chave-1"
+)
+
+// mockIdP é um provedor OIDC mínimo: publica a descoberta e o JWKS e devolve
+// no token endpoint um ID token assinado com as claims e o kid configurados.
+type mockIdP struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	issuer string
+	kid    string
+	claims jwt.MapClaims
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey: %v", err)
+	}
+
+	idp := &mockIdP{key: key, kid: testKid}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(discovery{
+			Issuer:                idp.issuer,
+			AuthorizationEndpoint: idp.server.URL + "/authorize",
+			TokenEndpoint:         idp.server.URL + "/token",
+			JWKSURI:               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jwks{Keys: []jwk{{
+			Kid: testKid,
+			Kty: "RSA",
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("code") != testCode || r.PostFormValue("code_verifier") != testVerifier {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, idp.claims)
+		token.Header["kid"] = idp.kid
+		signed, err := token.SignedString(idp.key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]string{"id_token": signed})
+	})
+
+	idp.server = httptest.NewServer(mux)
+	idp.issuer = idp.server.URL
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+func (idp *mockIdP) provider() *Provider {
+	return New(&config.OIDC{
+		IssuerURL:   idp.server.URL,
+		ClientID:    testClientID,
+		RedirectURL: "https://api.example.com/auth/oidc/callback",
+		Scopes:      []string{"openid", "email"},
+		GroupsClaim: "groups",
+	}).(*Provider)
+}
+
+func validClaims(issuer string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":            issuer,
+		"aud":            testClientID,
+		"sub":            "usuario-1",
+		"exp":            now.Add(time.Hour).Unix(),
+		"iat":            now.Unix(),
+		"nonce":          testNonce,
+		"email":          "maria@example.com",
+		"email_verified": true,
+		"groups":         []string{"backup-admins"},
+		"amr":            []string{"pwd", "otp"},
+	}
+}
+
+func TestProviderAuthCodeURL(t *testing.T) {
+	idp := newMockIdP(t)
+
+	authURL, err := idp.provider().AuthCodeURL(context.Background(), "state", testNonce, "desafio")
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("url.Parse: %v", err)
+	}
+	if got := parsed.Scheme + "://" + parsed.Host + parsed.Path; got != idp.server.URL+"/authorize" {
+		t.Errorf("endpoint = %s, want %s/authorize", got, idp.server.URL)
+	}
+
+	query := parsed.Query()
+	want := map[string]string{
+		"response_type":         "code",
+		"client_id":             testClientID,
+		"state":                 "state",
+		"nonce":                 testNonce,
+		"code_challenge":        "desafio",
+		"code_challenge_method": "S256",
+		"scope":                 "openid email",
+	}
+	for name, value := range want {
+		if query.Get(name) != value {
+			t.Errorf("%s = %q, want %q", name, query.Get(name), value)
+		}
+	}
+}
+
+func TestProviderDiscoveryIssuerMismatch(t *testing.T) {
+	idp := newMockIdP(t)
+	idp.issuer = "https://outro-emissor.example.com"
+
+	_, err := idp.provider().AuthCodeURL(context.Background(), "state", testNonce, "desafio")
+	if !errors.Is(err, domain.ErrOIDCProvider) {
+		t.Fatalf("AuthCodeURL = %v, want %v", err, domain.ErrOIDCProvider)
+	}
+}
+
+func TestProviderExchange(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   string
+		kid    string
+		mutate func(claims jwt.MapClaims, issuer string)
+		want   error
+	}{
+		{name: "token válido"},
+		{name: "código recusado pelo provedor", code: "outro", want: domain.ErrInvalidOIDCState},
+		{name: "nonce divergente", mutate: func(c jwt.MapClaims, _ string) { c["nonce"] = "outro" }, want: domain.ErrInvalidToken},
+		{name: "audiência de outro cliente", mutate: func(c jwt.MapClaims, _ string) { c["aud"] = "outro-cliente" }, want: domain.ErrInvalidToken},
+		{name: "expirado além da tolerância", mutate: func(c jwt.MapClaims, _ string) { c["exp"] = time.Now().Add(-2 * clockLeeway).Unix() }, want: domain.ErrInvalidToken},
+		{name: "expirado dentro da tolerância", mutate: func(c jwt.MapClaims, _ string) { c["exp"] = time.Now().Add(-clockLeeway / 2).Unix() }},
+		{name: "sem exp", mutate: func(c jwt.MapClaims, _ string) { delete(c, "exp") }, want: domain.ErrInvalidToken},
+		{name: "emissor diferente", mutate: func(c jwt.MapClaims, issuer string) { c["iss"] = issuer + "/outro" }, want: domain.ErrInvalidToken},
+		{name: "sem sub", mutate: func(c jwt.MapClaims, _ string) { delete(c, "sub") }, want: domain.ErrInvalidToken},
+		{name: "chave fora do JWKS", kid: "chave-2", want: domain.ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := newMockIdP(t)
+			idp.claims = validClaims(idp.issuer)
+			if tt.mutate != nil {
+				tt.mutate(idp.claims, idp.issuer)
+			}
+			if tt.kid != "" {
+				idp.kid = tt.kid
+			}
+			code := testCode
+			if tt.code != "" {
+				code = tt.code
+			}
+
+			identity, err := idp.provider().Exchange(context.Background(), code, testVerifier, testNonce)
+			if tt.want != nil {
+				if !errors.Is(err, tt.want) {
+					t.Fatalf("Exchange = %v, want %v", err, tt.want)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Exchange: %v", err)
+			}
+
+			if identity.Subject != "usuario-1" || identity.Email != "maria@example.com" || !identity.EmailVerified {
+				t.Errorf("identidade = %+v", identity)
+			}
+			if strings.Join(identity.Groups, ",") != "backup-admins" {
+				t.Errorf("Groups = %v, want [backup-admins]", identity.Groups)
+			}
+			if !identity.MFA {
+				t.Error("MFA = false, want true com amr otp")
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"math"
+	"os"
+	"strings"
+)
+
+// BloomFilter guarda a lista de senhas vazadas sem mantê-la em memória. Pode
+// haver falsos positivos na taxa configurada, nunca falsos negativos.
+type BloomFilter struct {
+	bits []uint64
+	m    uint64
+	k    uint64
+}
+
+// LoadBloomFilter lê um arquivo com uma entrada por linha: a senha em texto
+// ou o SHA-1 em hexadecimal, opcionalmente seguido de ":contagem" como nas
+// listas do Have I Been Pwned.
+func LoadBloomFilter(path string, falsePositiveRate float64) (*BloomFilter, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	n, err := countLines(f)
+	if err != nil {
+		return nil, err
+	}
+
+	bf := newBloomFilter(max(n, 1), falsePositiveRate)
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if digest, ok := entryDigest(scanner.Text()); ok {
+			bf.add(digest)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return bf, nil
+}
+
+func newBloomFilter(n int, falsePositiveRate float64) *BloomFilter {
+	m := uint64(math.Ceil(-float64(n) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	k := uint64(math.Max(1, math.Round(float64(m)/float64(n)*math.Ln2)))
+
+	return &BloomFilter{
+		bits: make([]uint64, (m+63)/64),
+		m:    m,
+		k:    k,
+	}
+}
+
+func (bf *BloomFilter) IsBreached(password string) bool {
+	digest := sha1.Sum([]byte(password))
+
+	h1, h2 := split(digest)
+	for i := range bf.k {
+		bit := (h1 + i*h2) % bf.m
+		if bf.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (bf *BloomFilter) add(digest [sha1.Size]byte) {
+	h1, h2 := split(digest)
+	for i := range bf.k {
+		bit := (h1 + i*h2) % bf.m
+		bf.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// split deriva os dois hashes usados na técnica de double hashing a partir
+// do próprio SHA-1, que já é uniformemente distribuído.
+func split(digest [sha1.Size]byte) (uint64, uint64) {
+	h1 := binary.BigEndian.Uint64(digest[0:8])
+	h2 := binary.BigEndian.Uint64(digest[8:16]) | 1
+	return h1, h2
+}
+
+func entryDigest(line string) ([sha1.Size]byte, bool) {
+	line = strings.TrimRight(line, "\r")
+	if line == "" {
+		return [sha1.Size]byte{}, false
+	}
+
+	candidate, _, _ := strings.Cut(line, ":")
+	if len(candidate) == hex.EncodedLen(sha1.Size) {
+		var digest [sha1.Size]byte
+		if _, err := hex.Decode(digest[:], []byte(candidate)); err == nil {
+			return digest, true
+		}
+	}
+
+	return sha1.Sum([]byte(line)), true
+}
+
+func countLines(r io.Reader) (int, error) {
+	n := 0
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		n++
+	}
+	return n, scanner.Err()
+}
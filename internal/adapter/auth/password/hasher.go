@@ -0,0 +1,157 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/config"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	argon2SaltSize = 16
+	argon2KeySize  = 32
+
+	dummyPassword = "dummy-password"
+)
+
+var errInvalidHash = errors.New("hash de senha em formato desconhecido")
+
+type argon2Params struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// Hasher gera hashes no algoritmo configurado e verifica tanto Argon2id
+// quanto bcrypt, sinalizando quando um hash antigo deve ser refeito.
+type Hasher struct {
+	algorithm  string
+	bcryptCost int
+	argon2     argon2Params
+
+	// Hashes fictícios usados para igualar o custo das verificações.
+	dummyOnce   sync.Once
+	dummyBcrypt []byte
+	dummyArgon2 string
+}
+
+func NewHasher(config *config.Password) *Hasher {
+	return &Hasher{
+		algorithm:  config.HashAlgorithm,
+		bcryptCost: config.BcryptCost,
+		argon2: argon2Params{
+			memory:      uint32(config.Argon2Memory),
+			iterations:  uint32(config.Argon2Iterations),
+			parallelism: uint8(config.Argon2Parallelism),
+		},
+	}
+}
+
+func (h *Hasher) Hash(password string) (string, error) {
+	if h.algorithm == "bcrypt" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+		if err != nil {
+			return "", err
+		}
+		return string(hash), nil
+	}
+
+	return h.hashArgon2(password)
+}
+
+func (h *Hasher) hashArgon2(password string) (string, error) {
+	salt := make([]byte, argon2SaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	p := h.argon2
+	key := argon2.IDKey([]byte(password), salt, p.iterations, p.memory, p.parallelism, argon2KeySize)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, p.memory, p.iterations, p.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify sempre calcula os dois algoritmos: o do hash informado e o outro
+// contra um hash fictício. Assim um usuário com hash bcrypt antigo custa o
+// mesmo que um com Argon2id ou que um username inexistente, e o tempo de
+// resposta não revela quais usernames existem.
+func (h *Hasher) Verify(hash, password string) (bool, bool, error) {
+	h.dummyOnce.Do(h.initDummies)
+
+	if strings.HasPrefix(hash, "$argon2id$") {
+		bcrypt.CompareHashAndPassword(h.dummyBcrypt, []byte(password))
+		return h.verifyArgon2(hash, password)
+	}
+
+	if strings.HasPrefix(hash, "$2") {
+		h.verifyArgon2(h.dummyArgon2, password)
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, false, nil
+		}
+		if err != nil {
+			return false, false, err
+		}
+
+		cost, err := bcrypt.Cost([]byte(hash))
+		if err != nil {
+			return true, true, nil
+		}
+		return true, h.algorithm != "bcrypt" || cost != h.bcryptCost, nil
+	}
+
+	return false, false, errInvalidHash
+}
+
+func (h *Hasher) verifyArgon2(hash, password string) (bool, bool, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return false, false, errInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, false, errInvalidHash
+	}
+
+	var p argon2Params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.iterations, &p.parallelism); err != nil {
+		return false, false, errInvalidHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, false, errInvalidHash
+	}
+
+	expected, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return false, false, errInvalidHash
+	}
+
+	key := argon2.IDKey([]byte(password), salt, p.iterations, p.memory, p.parallelism, uint32(len(expected)))
+	if subtle.ConstantTimeCompare(key, expected) != 1 {
+		return false, false, nil
+	}
+
+	return true, h.algorithm != "argon2id" || p != h.argon2, nil
+}
+
+// initDummies gera os hashes fictícios com os parâmetros configurados. Se a
+// geração falhar, a verificação correspondente falha de imediato, o que só
+// reduz o custo.
+func (h *Hasher) initDummies() {
+	h.dummyBcrypt, _ = bcrypt.GenerateFromPassword([]byte(dummyPassword), h.bcryptCost)
+	h.dummyArgon2, _ = h.hashArgon2(dummyPassword)
+}
@@ -0,0 +1,110 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+)
+
+const (
+	secretSize = 20
+	period     = 30 * time.Second
+	digits     = 6
+	// skew aceita um passo antes e um depois para tolerar relógios dessincronizados.
+	skew = 1
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TOTP implementa a RFC 6238 com HMAC-SHA1, passos de 30 segundos e códigos
+// de 6 dígitos, os parâmetros suportados pelos aplicativos autenticadores.
+type TOTP struct {
+	issuer string
+}
+
+func New(issuer string) port.TOTPService {
+	return &TOTP{
+		issuer: issuer,
+	}
+}
+
+func (t *TOTP) GenerateSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(secret), nil
+}
+
+func (t *TOTP) URI(secret, account string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", t.issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(digits))
+	query.Set("period", fmt.Sprint(int(period.Seconds())))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + t.issuer + ":" + account,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
+
+// Validate devolve o passo de tempo em que o código é válido. Passos até
+// lastStep já foram usados e são recusados, impedindo a reutilização de um
+// mesmo código.
+func (t *TOTP) Validate(secret, code string, at time.Time, lastStep int64) (int64, bool) {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != digits {
+		return 0, false
+	}
+
+	current := Step(at)
+	for offset := int64(-skew); offset <= skew; offset++ {
+		step := current + offset
+		if step <= lastStep {
+			continue
+		}
+
+		expected := HOTP(key, uint64(step), digits)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+func Step(at time.Time) int64 {
+	return at.Unix() / int64(period.Seconds())
+}
+
+// HOTP calcula o código da RFC 4226 para o contador informado.
+func HOTP(key []byte, counter uint64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range digits {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
@@ -0,0 +1,124 @@
+package totp
+
+import (
+	"testing"
+	"time"
+)
+
+// Segredo dos vetores de teste SHA1 da RFC 6238, apêndice B.
+const (
+	rfcKey    = "12345678901234567890"
+	rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+)
+
+func TestHOTPRFC6238Vectors(t *testing.T) {
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+	}
+
+	for _, tt := range tests {
+		at := time.Unix(tt.unix, 0)
+		if got := HOTP([]byte(rfcKey), uint64(Step(at)), 8); got != tt.code {
+			t.Errorf("T=%d: HOTP = %s, want %s", tt.unix, got, tt.code)
+		}
+	}
+}
+
+func TestValidateRFC6238Vectors(t *testing.T) {
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	totp := &TOTP{issuer: "test"}
+	for _, tt := range tests {
+		at := time.Unix(tt.unix, 0)
+		step, ok := totp.Validate(rfcSecret, tt.code, at, 0)
+		if !ok || step != Step(at) {
+			t.Errorf("T=%d: Validate = (%d, %v), want (%d, true)", tt.unix, step, ok, Step(at))
+		}
+	}
+}
+
+func TestValidateSkew(t *testing.T) {
+	totp := &TOTP{issuer: "test"}
+	at := time.Unix(1111111111, 0)
+	current := Step(at)
+
+	tests := []struct {
+		name   string
+		offset int64
+		ok     bool
+	}{
+		{"dois passos antes", -2, false},
+		{"passo anterior", -1, true},
+		{"passo atual", 0, true},
+		{"próximo passo", 1, true},
+		{"dois passos depois", 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := HOTP([]byte(rfcKey), uint64(current+tt.offset), digits)
+			step, ok := totp.Validate(rfcSecret, code, at, 0)
+			if ok != tt.ok {
+				t.Fatalf("Validate ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && step != current+tt.offset {
+				t.Errorf("Validate step = %d, want %d", step, current+tt.offset)
+			}
+		})
+	}
+}
+
+func TestValidateRejectsUsedStep(t *testing.T) {
+	totp := &TOTP{issuer: "test"}
+	at := time.Unix(1111111111, 0)
+	code := HOTP([]byte(rfcKey), uint64(Step(at)), digits)
+
+	step, ok := totp.Validate(rfcSecret, code, at, 0)
+	if !ok {
+		t.Fatal("primeiro uso do código foi recusado")
+	}
+
+	if _, ok := totp.Validate(rfcSecret, code, at, step); ok {
+		t.Error("código do passo já usado foi aceito novamente")
+	}
+
+	// Um código anterior ao último passo usado também é recusado, mesmo
+	// dentro da tolerância.
+	previous := HOTP([]byte(rfcKey), uint64(step-1), digits)
+	if _, ok := totp.Validate(rfcSecret, previous, at, step); ok {
+		t.Error("código de passo anterior ao último usado foi aceito")
+	}
+
+	next := HOTP([]byte(rfcKey), uint64(step+1), digits)
+	if got, ok := totp.Validate(rfcSecret, next, at, step); !ok || got != step+1 {
+		t.Errorf("Validate do próximo passo = (%d, %v), want (%d, true)", got, ok, step+1)
+	}
+}
+
+func TestValidateRejectsMalformedInput(t *testing.T) {
+	totp := &TOTP{issuer: "test"}
+	at := time.Unix(59, 0)
+
+	if _, ok := totp.Validate(rfcSecret, "28708", at, 0); ok {
+		t.Error("código com menos dígitos foi aceito")
+	}
+	if _, ok := totp.Validate("não é base32", "287082", at, 0); ok {
+		t.Error("segredo inválido foi aceito")
+	}
+}
@@ -1,59 +1,576 @@
 package config
 
 import (
-	"os"
-
-	"github.com/joho/godotenv"
+	"fmt"
+	"io"
+	netmail "net/mail"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
 )
 
 type Config struct {
-	DB    *DB
-	HTTP  *HTTP
-	Token *Token
+	DB       *DB
+	HTTP     *HTTP
+	Token    *Token
+	Tracing  *Tracing
+	Log      *Log
+	Login    *Login
+	MFA      *MFA
+	Mail     *Mail
+	Account  *Account
+	Password *Password
+	OIDC     *OIDC
+	Secrets  *Secrets
+	Agent    *Agent
+
+	entries []entry
 }
 
 type DB struct {
-	User string
-	Pass string
-	Host string
-	Port string
-	Name string
+	// URL, quando informada, substitui User, Pass, Host, Port e Name.
+	URL               string
+	User              string
+	Pass              string
+	Host              string
+	Port              int
+	Name              string
+	SSLMode           string
+	SSLRootCert       string
+	SSLCert           string
+	SSLKey            string
+	MaxConns          int
+	MinConns          int
+	MaxConnLifetime   time.Duration
+	MaxConnIdleTime   time.Duration
+	HealthCheckPeriod time.Duration
+	ConnectTimeout    time.Duration
+	StatementTimeout  time.Duration
+	ApplicationName   string
 }
 
 type HTTP struct {
-	Port string
+	Port               int
+	ReadTimeout        time.Duration
+	WriteTimeout       time.Duration
+	IdleTimeout        time.Duration
+	ShutdownTimeout    time.Duration
+	CORSAllowedOrigins []string
+	CORSMaxAge         int
+	// MetricsToken protege /metrics; vazio desativa o endpoint.
+	MetricsToken string
+	// TrustedProxies são as redes dos proxies (ingress, balanceador) cujos
+	// X-Forwarded-For e X-Real-IP são aceitos como IP do cliente.
+	TrustedProxies []netip.Prefix
 }
 
 type Token struct {
-	Duration     string
-	JwtSecretKey string
+	Duration             time.Duration
+	JwtSecretKey         string
+	MFAChallengeDuration time.Duration
+}
+
+type Tracing struct {
+	// Exporter pode ser none, stdout ou otlp.
+	Exporter     string
+	OTLPEndpoint string
+	SampleRatio  float64
+	ServiceName  string
+}
+
+type Log struct {
+	// Level pode ser debug, info, warn ou error; Format pode ser json ou text.
+	Level  string
+	Format string
+}
+
+type Login struct {
+	// RateLimitStore pode ser memory (uma réplica) ou postgres (compartilhado).
+	RateLimitStore      string
+	IPBurst             int
+	IPRefill            time.Duration
+	UsernameBurst       int
+	UsernameRefill      time.Duration
+	LockoutThreshold    int
+	LockoutBaseDuration time.Duration
+	LockoutMaxDuration  time.Duration
+}
+
+type MFA struct {
+	// Issuer aparece no aplicativo autenticador ao lado da conta.
+	Issuer          string
+	RecoveryCodes   int
+	RequireForAdmin bool
+}
+
+type Mail struct {
+	// Driver pode ser log, file ou smtp.
+	Driver   string
+	From     string
+	FileDir  string
+	SMTPHost string
+	SMTPPort int
+	SMTPUser string
+	SMTPPass string
+	// SMTPTLS pode ser none, starttls ou tls.
+	SMTPTLS     string
+	SMTPTimeout time.Duration
+	// QueueWorkers e QueueSize limitam os envios em segundo plano; com a
+	// fila cheia novos envios são descartados e registrados no log.
+	QueueWorkers int
+	QueueSize    int
+}
+
+type Account struct {
+	// BaseURL é a URL do frontend usada nos links enviados por e-mail.
+	BaseURL                  string
+	PasswordResetTTL         time.Duration
+	EmailVerificationTTL     time.Duration
+	RequireEmailVerification bool
+}
+
+type Password struct {
+	// HashAlgorithm pode ser argon2id ou bcrypt; hashes em outro formato são
+	// refeitos no próximo login.
+	HashAlgorithm     string
+	BcryptCost        int
+	Argon2Memory      int
+	Argon2Iterations  int
+	Argon2Parallelism int
+	MinLength         int
+	MaxLength         int
+	RequireUpper      bool
+	RequireLower      bool
+	RequireDigit      bool
+	RequireSymbol     bool
+	HistorySize       int
+	// BreachedList aponta para a lista de senhas vazadas; vazio desativa a checagem.
+	BreachedList              string
+	BreachedFalsePositiveRate float64
+}
+
+type OIDC struct {
+	Enabled bool
+	// IssuerURL é usada na descoberta (/.well-known/openid-configuration) e
+	// precisa ser igual ao iss dos ID tokens.
+	IssuerURL     string
+	ClientID      string
+	ClientSecret  string
+	RedirectURL   string
+	Scopes        []string
+	StateTTL      time.Duration
+	AutoProvision bool
+	DefaultRole   string
+	GroupsClaim   string
+	// RoleMapping vem de OIDC_ROLE_MAPPING no formato grupo=papel,grupo=papel.
+	RoleMapping map[string]string
+}
+
+// Secrets guarda as chaves mestras que cifram as credenciais salvas no banco.
+// Para rotacionar, configure a nova chave em MasterKey/MasterKeyID, mova a
+// anterior para PreviousKeys e execute a rotação pela API.
+type Secrets struct {
+	MasterKeyID string
+	// MasterKey vem de SECRETS_MASTER_KEY em base64 (32 bytes); vazia, o
+	// cadastro de credenciais fica desativado.
+	MasterKey []byte
+	// PreviousKeys vem de SECRETS_PREVIOUS_KEYS no formato id=base64,id=base64.
+	PreviousKeys map[string][]byte
+}
+
+type Agent struct {
+	// CommandTTL é a validade padrão dos comandos enfileirados; o operador
+	// pode pedir outra, limitada a CommandMaxTTL.
+	CommandTTL    time.Duration
+	CommandMaxTTL time.Duration
+	// CommandPollWait é a espera máxima do long-poll de GET /agent/commands.
+	CommandPollWait time.Duration
+}
+
+type Options struct {
+	// DotEnvPath é opcional; quando o arquivo não existir ele é ignorado.
+	DotEnvPath string
+	// FilePath aponta para um arquivo YAML opcional com as mesmas chaves
+	// das variáveis de ambiente, agrupadas por seção (db, http, token).
+	FilePath string
+}
+
+type ValidationError struct {
+	Problems []string
 }
 
-func New() (*Config, error) {
-	if err := godotenv.Load(); err != nil {
+func (e *ValidationError) Error() string {
+	return "configuração inválida: " + strings.Join(e.Problems, "; ")
+}
+
+var sslModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+func New(opts Options) (*Config, error) {
+	if opts.DotEnvPath == "" {
+		opts.DotEnvPath = ".env"
+	}
+
+	l, err := newLoader(opts.DotEnvPath, opts.FilePath)
+	if err != nil {
 		return nil, err
 	}
 
 	db := &DB{
-		User: os.Getenv("DB_USER"),
-		Pass: os.Getenv("DB_PASS"),
-		Host: os.Getenv("DB_HOST"),
-		Port: os.Getenv("DB_PORT"),
-		Name: os.Getenv("DB_NAME"),
+		URL:               l.secret("DATABASE_URL", ""),
+		User:              l.string("DB_USER", ""),
+		Pass:              l.secret("DB_PASS", ""),
+		Host:              l.string("DB_HOST", ""),
+		Port:              l.int("DB_PORT", 5432),
+		Name:              l.string("DB_NAME", ""),
+		SSLMode:           l.string("DB_SSLMODE", "disable"),
+		SSLRootCert:       l.string("DB_SSLROOTCERT", ""),
+		SSLCert:           l.string("DB_SSLCERT", ""),
+		SSLKey:            l.string("DB_SSLKEY", ""),
+		MaxConns:          l.int("DB_MAX_CONNS", 10),
+		MinConns:          l.int("DB_MIN_CONNS", 0),
+		MaxConnLifetime:   l.duration("DB_MAX_CONN_LIFETIME", time.Hour),
+		MaxConnIdleTime:   l.duration("DB_MAX_CONN_IDLE_TIME", 30*time.Minute),
+		HealthCheckPeriod: l.duration("DB_HEALTH_CHECK_PERIOD", time.Minute),
+		ConnectTimeout:    l.duration("DB_CONNECT_TIMEOUT", 5*time.Second),
+		StatementTimeout:  l.duration("DB_STATEMENT_TIMEOUT", 30*time.Second),
+		ApplicationName:   l.string("DB_APPLICATION_NAME", "go-backup-management-api"),
 	}
 
 	http := &HTTP{
-		Port: os.Getenv("HTTP_PORT"),
+		Port:               l.int("HTTP_PORT", 8080),
+		ReadTimeout:        l.duration("HTTP_READ_TIMEOUT", 5*time.Minute),
+		WriteTimeout:       l.duration("HTTP_WRITE_TIMEOUT", 5*time.Minute),
+		IdleTimeout:        l.duration("HTTP_IDLE_TIMEOUT", time.Minute),
+		ShutdownTimeout:    l.duration("HTTP_SHUTDOWN_TIMEOUT", 30*time.Second),
+		CORSAllowedOrigins: l.list("HTTP_CORS_ALLOWED_ORIGINS", []string{"https://*", "http://*"}),
+		CORSMaxAge:         l.int("HTTP_CORS_MAX_AGE", 300),
+		MetricsToken:       l.secret("HTTP_METRICS_TOKEN", ""),
+	}
+	for _, item := range l.list("HTTP_TRUSTED_PROXIES", nil) {
+		prefix, err := netip.ParsePrefix(item)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(item)
+			if addrErr != nil {
+				l.problem("HTTP_TRUSTED_PROXIES: use um IP ou uma rede CIDR (%q)", item)
+				continue
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		http.TrustedProxies = append(http.TrustedProxies, prefix.Masked())
 	}
 
 	token := &Token{
-		Duration:     os.Getenv("TOKEN_DURATION"),
-		JwtSecretKey: os.Getenv("JWT_SECRET_KEY"),
+		Duration:             l.duration("TOKEN_DURATION", 24*time.Hour),
+		JwtSecretKey:         l.secret("TOKEN_SECRET_KEY", "", "JWT_SECRET_KEY"),
+		MFAChallengeDuration: l.duration("TOKEN_MFA_CHALLENGE_DURATION", 5*time.Minute),
+	}
+
+	tracing := &Tracing{
+		Exporter:     l.string("TRACING_EXPORTER", "none"),
+		OTLPEndpoint: l.string("TRACING_OTLP_ENDPOINT", ""),
+		SampleRatio:  l.float("TRACING_SAMPLE_RATIO", 1),
+		ServiceName:  l.string("TRACING_SERVICE_NAME", "go-backup-management-api"),
+	}
+
+	log := &Log{
+		Level:  l.string("LOG_LEVEL", "info"),
+		Format: l.string("LOG_FORMAT", "json"),
+	}
+
+	login := &Login{
+		RateLimitStore:      l.string("LOGIN_RATE_LIMIT_STORE", "memory"),
+		IPBurst:             l.int("LOGIN_IP_BURST", 20),
+		IPRefill:            l.duration("LOGIN_IP_REFILL", 3*time.Second),
+		UsernameBurst:       l.int("LOGIN_USERNAME_BURST", 5),
+		UsernameRefill:      l.duration("LOGIN_USERNAME_REFILL", 30*time.Second),
+		LockoutThreshold:    l.int("LOGIN_LOCKOUT_THRESHOLD", 5),
+		LockoutBaseDuration: l.duration("LOGIN_LOCKOUT_BASE_DURATION", time.Minute),
+		LockoutMaxDuration:  l.duration("LOGIN_LOCKOUT_MAX_DURATION", 24*time.Hour),
+	}
+
+	mfa := &MFA{
+		Issuer:          l.string("MFA_ISSUER", "Backup Management"),
+		RecoveryCodes:   l.int("MFA_RECOVERY_CODES", 10),
+		RequireForAdmin: l.bool("MFA_REQUIRE_FOR_ADMIN", false),
+	}
+
+	mail := &Mail{
+		Driver:       l.string("MAIL_DRIVER", "log"),
+		From:         l.string("MAIL_FROM", "Backup Management <no-reply@localhost>"),
+		FileDir:      l.string("MAIL_FILE_DIR", "./tmp/mail"),
+		SMTPHost:     l.string("SMTP_HOST", ""),
+		SMTPPort:     l.int("SMTP_PORT", 587),
+		SMTPUser:     l.string("SMTP_USER", ""),
+		SMTPPass:     l.secret("SMTP_PASS", ""),
+		SMTPTLS:      l.string("SMTP_TLS", "starttls"),
+		SMTPTimeout:  l.duration("SMTP_TIMEOUT", 10*time.Second),
+		QueueWorkers: l.int("MAIL_QUEUE_WORKERS", 4),
+		QueueSize:    l.int("MAIL_QUEUE_SIZE", 100),
+	}
+
+	account := &Account{
+		BaseURL:                  l.string("ACCOUNT_BASE_URL", "http://localhost:3000"),
+		PasswordResetTTL:         l.duration("ACCOUNT_PASSWORD_RESET_TTL", time.Hour),
+		EmailVerificationTTL:     l.duration("ACCOUNT_EMAIL_VERIFICATION_TTL", 48*time.Hour),
+		RequireEmailVerification: l.bool("ACCOUNT_REQUIRE_EMAIL_VERIFICATION", false),
+	}
+
+	password := &Password{
+		HashAlgorithm:             l.string("PASSWORD_HASH_ALGORITHM", "argon2id"),
+		BcryptCost:                l.int("PASSWORD_BCRYPT_COST", 10),
+		Argon2Memory:              l.int("PASSWORD_ARGON2_MEMORY", 64*1024),
+		Argon2Iterations:          l.int("PASSWORD_ARGON2_ITERATIONS", 3),
+		Argon2Parallelism:         l.int("PASSWORD_ARGON2_PARALLELISM", 2),
+		MinLength:                 l.int("PASSWORD_MIN_LENGTH", 8),
+		MaxLength:                 l.int("PASSWORD_MAX_LENGTH", 128),
+		RequireUpper:              l.bool("PASSWORD_REQUIRE_UPPER", true),
+		RequireLower:              l.bool("PASSWORD_REQUIRE_LOWER", true),
+		RequireDigit:              l.bool("PASSWORD_REQUIRE_DIGIT", true),
+		RequireSymbol:             l.bool("PASSWORD_REQUIRE_SYMBOL", false),
+		HistorySize:               l.int("PASSWORD_HISTORY_SIZE", 5),
+		BreachedList:              l.string("PASSWORD_BREACHED_LIST", ""),
+		BreachedFalsePositiveRate: l.float("PASSWORD_BREACHED_FALSE_POSITIVE_RATE", 0.001),
+	}
+
+	oidc := &OIDC{
+		Enabled:       l.bool("OIDC_ENABLED", false),
+		IssuerURL:     l.string("OIDC_ISSUER_URL", ""),
+		ClientID:      l.string("OIDC_CLIENT_ID", ""),
+		ClientSecret:  l.secret("OIDC_CLIENT_SECRET", ""),
+		RedirectURL:   l.string("OIDC_REDIRECT_URL", ""),
+		Scopes:        l.list("OIDC_SCOPES", []string{"openid", "email", "profile"}),
+		StateTTL:      l.duration("OIDC_STATE_TTL", 10*time.Minute),
+		AutoProvision: l.bool("OIDC_AUTO_PROVISION", true),
+		DefaultRole:   l.string("OIDC_DEFAULT_ROLE", "member"),
+		GroupsClaim:   l.string("OIDC_GROUPS_CLAIM", "groups"),
+		RoleMapping:   map[string]string{},
+	}
+	for _, item := range l.list("OIDC_ROLE_MAPPING", nil) {
+		group, role, ok := strings.Cut(item, "=")
+		if !ok || strings.TrimSpace(group) == "" {
+			l.problem("OIDC_ROLE_MAPPING: use o formato grupo=papel (%q)", item)
+			continue
+		}
+		role = strings.TrimSpace(role)
+		l.oneOf("OIDC_ROLE_MAPPING", role, "admin", "member")
+		oidc.RoleMapping[strings.TrimSpace(group)] = role
+	}
+
+	secrets := &Secrets{
+		MasterKeyID:  l.string("SECRETS_MASTER_KEY_ID", "v1"),
+		MasterKey:    l.masterKey("SECRETS_MASTER_KEY", l.secret("SECRETS_MASTER_KEY", "")),
+		PreviousKeys: map[string][]byte{},
+	}
+	for _, item := range strings.Split(l.secret("SECRETS_PREVIOUS_KEYS", ""), ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		id, key, ok := strings.Cut(item, "=")
+		if !ok || strings.TrimSpace(id) == "" {
+			l.problem("SECRETS_PREVIOUS_KEYS: use o formato id=chave")
+			continue
+		}
+		secrets.PreviousKeys[strings.TrimSpace(id)] = l.masterKey("SECRETS_PREVIOUS_KEYS", key)
+	}
+
+	agent := &Agent{
+		CommandTTL:      l.duration("AGENT_COMMAND_TTL", time.Hour),
+		CommandMaxTTL:   l.duration("AGENT_COMMAND_MAX_TTL", 7*24*time.Hour),
+		CommandPollWait: l.duration("AGENT_COMMAND_POLL_WAIT", 30*time.Second),
+	}
+
+	if db.URL == "" {
+		l.required("DB_USER", db.User)
+		l.required("DB_HOST", db.Host)
+		l.required("DB_NAME", db.Name)
+		l.port("DB_PORT", db.Port)
+	}
+	l.oneOf("DB_SSLMODE", db.SSLMode, sslModes...)
+	if (db.SSLCert == "") != (db.SSLKey == "") {
+		l.problem("DB_SSLCERT e DB_SSLKEY devem ser informados juntos")
+	}
+	l.fileExists("DB_SSLROOTCERT", db.SSLRootCert)
+	l.fileExists("DB_SSLCERT", db.SSLCert)
+	l.fileExists("DB_SSLKEY", db.SSLKey)
+	if db.MaxConns < 1 {
+		l.problem("DB_MAX_CONNS: deve ser maior que zero")
+	}
+	if db.MinConns < 0 || db.MinConns > db.MaxConns {
+		l.problem("DB_MIN_CONNS: deve estar entre 0 e DB_MAX_CONNS (%d)", db.MaxConns)
+	}
+	l.positive("DB_MAX_CONN_LIFETIME", db.MaxConnLifetime)
+	l.positive("DB_MAX_CONN_IDLE_TIME", db.MaxConnIdleTime)
+	l.positive("DB_HEALTH_CHECK_PERIOD", db.HealthCheckPeriod)
+	l.positive("DB_CONNECT_TIMEOUT", db.ConnectTimeout)
+	if db.StatementTimeout < 0 {
+		l.problem("DB_STATEMENT_TIMEOUT: não pode ser negativo")
+	}
+
+	l.port("HTTP_PORT", http.Port)
+	l.positive("HTTP_READ_TIMEOUT", http.ReadTimeout)
+	l.positive("HTTP_WRITE_TIMEOUT", http.WriteTimeout)
+	l.positive("HTTP_IDLE_TIMEOUT", http.IdleTimeout)
+	l.positive("HTTP_SHUTDOWN_TIMEOUT", http.ShutdownTimeout)
+	if len(http.CORSAllowedOrigins) == 0 {
+		l.problem("HTTP_CORS_ALLOWED_ORIGINS: informe ao menos uma origem")
+	}
+
+	l.required("TOKEN_SECRET_KEY", token.JwtSecretKey)
+	l.positive("TOKEN_DURATION", token.Duration)
+	l.positive("TOKEN_MFA_CHALLENGE_DURATION", token.MFAChallengeDuration)
+
+	l.oneOf("TRACING_EXPORTER", tracing.Exporter, "none", "stdout", "otlp")
+	if tracing.SampleRatio < 0 || tracing.SampleRatio > 1 {
+		l.problem("TRACING_SAMPLE_RATIO: deve estar entre 0 e 1")
+	}
+	l.required("TRACING_SERVICE_NAME", tracing.ServiceName)
+
+	l.oneOf("LOG_LEVEL", log.Level, "debug", "info", "warn", "error")
+	l.oneOf("LOG_FORMAT", log.Format, "json", "text")
+
+	l.oneOf("LOGIN_RATE_LIMIT_STORE", login.RateLimitStore, "memory", "postgres")
+	if login.IPBurst < 1 {
+		l.problem("LOGIN_IP_BURST: deve ser maior que zero")
+	}
+	l.positive("LOGIN_IP_REFILL", login.IPRefill)
+	if login.UsernameBurst < 1 {
+		l.problem("LOGIN_USERNAME_BURST: deve ser maior que zero")
+	}
+	l.positive("LOGIN_USERNAME_REFILL", login.UsernameRefill)
+	if login.LockoutThreshold < 0 {
+		l.problem("LOGIN_LOCKOUT_THRESHOLD: não pode ser negativo (0 desativa o bloqueio)")
+	}
+	l.positive("LOGIN_LOCKOUT_BASE_DURATION", login.LockoutBaseDuration)
+	if login.LockoutMaxDuration < login.LockoutBaseDuration {
+		l.problem("LOGIN_LOCKOUT_MAX_DURATION: deve ser maior ou igual a LOGIN_LOCKOUT_BASE_DURATION")
+	}
+
+	l.required("MFA_ISSUER", mfa.Issuer)
+	if strings.Contains(mfa.Issuer, ":") {
+		l.problem("MFA_ISSUER: não pode conter ':'")
+	}
+	if mfa.RecoveryCodes < 1 {
+		l.problem("MFA_RECOVERY_CODES: deve ser maior que zero")
+	}
+
+	l.oneOf("MAIL_DRIVER", mail.Driver, "log", "file", "smtp")
+	if _, err := netmail.ParseAddress(mail.From); err != nil {
+		l.problem("MAIL_FROM: endereço inválido %q", mail.From)
+	}
+	if mail.Driver == "file" {
+		l.required("MAIL_FILE_DIR", mail.FileDir)
+	}
+	if mail.Driver == "smtp" {
+		l.required("SMTP_HOST", mail.SMTPHost)
+		l.port("SMTP_PORT", mail.SMTPPort)
+		l.oneOf("SMTP_TLS", mail.SMTPTLS, "none", "starttls", "tls")
+		l.positive("SMTP_TIMEOUT", mail.SMTPTimeout)
+	}
+	if mail.QueueWorkers < 1 {
+		l.problem("MAIL_QUEUE_WORKERS: deve ser maior que zero")
+	}
+	if mail.QueueSize < 1 {
+		l.problem("MAIL_QUEUE_SIZE: deve ser maior que zero")
+	}
+
+	if u, err := url.Parse(account.BaseURL); err != nil || u.Scheme == "" || u.Host == "" {
+		l.problem("ACCOUNT_BASE_URL: URL inválida %q", account.BaseURL)
+	}
+	l.positive("ACCOUNT_PASSWORD_RESET_TTL", account.PasswordResetTTL)
+	l.positive("ACCOUNT_EMAIL_VERIFICATION_TTL", account.EmailVerificationTTL)
+
+	l.oneOf("PASSWORD_HASH_ALGORITHM", password.HashAlgorithm, "argon2id", "bcrypt")
+	if password.BcryptCost < 4 || password.BcryptCost > 31 {
+		l.problem("PASSWORD_BCRYPT_COST: deve estar entre 4 e 31")
+	}
+	if password.Argon2Memory < 8*password.Argon2Parallelism {
+		l.problem("PASSWORD_ARGON2_MEMORY: deve ser ao menos 8 KiB por thread")
+	}
+	if password.Argon2Iterations < 1 {
+		l.problem("PASSWORD_ARGON2_ITERATIONS: deve ser maior que zero")
+	}
+	if password.Argon2Parallelism < 1 || password.Argon2Parallelism > 255 {
+		l.problem("PASSWORD_ARGON2_PARALLELISM: deve estar entre 1 e 255")
+	}
+	if password.MinLength < 1 || password.MaxLength < password.MinLength {
+		l.problem("PASSWORD_MIN_LENGTH e PASSWORD_MAX_LENGTH: use 1 <= mínimo <= máximo")
+	}
+	// bcrypt ignora o que passar de 72 bytes.
+	if password.HashAlgorithm == "bcrypt" && password.MaxLength > 72 {
+		l.problem("PASSWORD_MAX_LENGTH: com bcrypt deve ser no máximo 72")
+	}
+	if password.HistorySize < 0 {
+		l.problem("PASSWORD_HISTORY_SIZE: não pode ser negativo (0 desativa)")
+	}
+	l.fileExists("PASSWORD_BREACHED_LIST", password.BreachedList)
+	if password.BreachedFalsePositiveRate <= 0 || password.BreachedFalsePositiveRate >= 1 {
+		l.problem("PASSWORD_BREACHED_FALSE_POSITIVE_RATE: deve estar entre 0 e 1")
+	}
+
+	if oidc.Enabled {
+		if u, err := url.Parse(oidc.IssuerURL); err != nil || u.Scheme == "" || u.Host == "" {
+			l.problem("OIDC_ISSUER_URL: URL inválida %q", oidc.IssuerURL)
+		}
+		l.required("OIDC_CLIENT_ID", oidc.ClientID)
+		if u, err := url.Parse(oidc.RedirectURL); err != nil || u.Scheme == "" || u.Host == "" {
+			l.problem("OIDC_REDIRECT_URL: URL inválida %q", oidc.RedirectURL)
+		}
+		if !slices.Contains(oidc.Scopes, "openid") {
+			l.problem("OIDC_SCOPES: deve incluir openid")
+		}
+		l.positive("OIDC_STATE_TTL", oidc.StateTTL)
+		l.oneOf("OIDC_DEFAULT_ROLE", oidc.DefaultRole, "admin", "member")
+		l.required("OIDC_GROUPS_CLAIM", oidc.GroupsClaim)
+	}
+
+	l.required("SECRETS_MASTER_KEY_ID", secrets.MasterKeyID)
+	if _, ok := secrets.PreviousKeys[secrets.MasterKeyID]; ok {
+		l.problem("SECRETS_PREVIOUS_KEYS: não pode repetir o id da chave atual %q", secrets.MasterKeyID)
+	}
+	if secrets.MasterKey == nil && len(secrets.PreviousKeys) > 0 {
+		l.problem("SECRETS_MASTER_KEY: obrigatória quando há chaves anteriores")
+	}
+
+	l.positive("AGENT_COMMAND_TTL", agent.CommandTTL)
+	if agent.CommandMaxTTL < agent.CommandTTL {
+		l.problem("AGENT_COMMAND_MAX_TTL: deve ser maior ou igual a AGENT_COMMAND_TTL")
+	}
+	if agent.CommandPollWait < 0 {
+		l.problem("AGENT_COMMAND_POLL_WAIT: não pode ser negativo (0 desativa o long-poll)")
+	}
+
+	if len(l.problems) > 0 {
+		return nil, &ValidationError{Problems: l.problems}
 	}
 
 	return &Config{
-		db,
-		http,
-		token,
+		DB:       db,
+		HTTP:     http,
+		Token:    token,
+		Tracing:  tracing,
+		Log:      log,
+		Login:    login,
+		MFA:      mfa,
+		Mail:     mail,
+		Account:  account,
+		Password: password,
+		OIDC:     oidc,
+		Secrets:  secrets,
+		Agent:    agent,
+		entries:  l.sortedEntries(),
 	}, nil
 }
+
+// Print escreve a configuração efetiva, uma chave por linha com a origem do
+// valor. Segredos nunca são exibidos.
+func (c *Config) Print(w io.Writer) {
+	for _, e := range c.entries {
+		value := e.value
+		if e.secret && value != "" {
+			value = redacted
+		}
+		fmt.Fprintf(w, "%s=%s\t# %s\n", e.key, value, e.source)
+	}
+}
@@ -0,0 +1,248 @@
+package config
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+const redacted = "******"
+
+type source string
+
+const (
+	sourceDefault source = "default"
+	sourceFile    source = "file"
+	sourceDotEnv  source = "dotenv"
+	sourceEnv     source = "env"
+)
+
+type entry struct {
+	key    string
+	value  string
+	source source
+	secret bool
+}
+
+// loader resolve cada chave na ordem: variável de ambiente, .env, arquivo
+// YAML e valor padrão. Os problemas encontrados são acumulados para que a
+// validação reporte todos de uma vez.
+type loader struct {
+	dotEnv   map[string]string
+	file     map[string]string
+	entries  []entry
+	problems []string
+}
+
+func newLoader(dotEnvPath, filePath string) (*loader, error) {
+	l := &loader{
+		dotEnv: map[string]string{},
+		file:   map[string]string{},
+	}
+
+	dotEnv, err := godotenv.Read(dotEnvPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("falha ao ler %s: %w", dotEnvPath, err)
+	}
+	if err == nil {
+		l.dotEnv = dotEnv
+	}
+
+	if filePath != "" {
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao ler o arquivo de configuração: %w", err)
+		}
+
+		var raw map[string]any
+		if err := yaml.Unmarshal(content, &raw); err != nil {
+			return nil, fmt.Errorf("arquivo de configuração inválido: %w", err)
+		}
+
+		flatten("", raw, l.file)
+	}
+
+	return l, nil
+}
+
+// flatten converte seções aninhadas do YAML em chaves no formato das
+// variáveis de ambiente, por exemplo db.max_conns vira DB_MAX_CONNS.
+func flatten(prefix string, raw map[string]any, out map[string]string) {
+	for k, v := range raw {
+		key := strings.ToUpper(k)
+		if prefix != "" {
+			key = prefix + "_" + key
+		}
+
+		switch value := v.(type) {
+		case map[string]any:
+			flatten(key, value, out)
+		case []any:
+			items := make([]string, len(value))
+			for i, item := range value {
+				items[i] = fmt.Sprint(item)
+			}
+			out[key] = strings.Join(items, ",")
+		case nil:
+		default:
+			out[key] = fmt.Sprint(value)
+		}
+	}
+}
+
+func (l *loader) lookup(keys ...string) (string, source, bool) {
+	for _, key := range keys {
+		if v, ok := os.LookupEnv(key); ok {
+			return v, sourceEnv, true
+		}
+	}
+	for _, key := range keys {
+		if v, ok := l.dotEnv[key]; ok {
+			return v, sourceDotEnv, true
+		}
+	}
+	for _, key := range keys {
+		if v, ok := l.file[key]; ok {
+			return v, sourceFile, true
+		}
+	}
+	return "", sourceDefault, false
+}
+
+func (l *loader) resolve(key string, def string, secret bool, aliases ...string) string {
+	value, src, ok := l.lookup(append([]string{key}, aliases...)...)
+	if !ok {
+		value = def
+	}
+	l.entries = append(l.entries, entry{key, value, src, secret})
+	return value
+}
+
+func (l *loader) problem(format string, args ...any) {
+	l.problems = append(l.problems, fmt.Sprintf(format, args...))
+}
+
+func (l *loader) string(key, def string, aliases ...string) string {
+	return l.resolve(key, def, false, aliases...)
+}
+
+func (l *loader) secret(key, def string, aliases ...string) string {
+	return l.resolve(key, def, true, aliases...)
+}
+
+func (l *loader) int(key string, def int) int {
+	raw := l.resolve(key, strconv.Itoa(def), false)
+	v, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil {
+		l.problem("%s: valor inteiro inválido %q", key, raw)
+		return def
+	}
+	return v
+}
+
+func (l *loader) float(key string, def float64) float64 {
+	raw := l.resolve(key, strconv.FormatFloat(def, 'f', -1, 64), false)
+	v, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+	if err != nil {
+		l.problem("%s: valor numérico inválido %q", key, raw)
+		return def
+	}
+	return v
+}
+
+func (l *loader) bool(key string, def bool) bool {
+	raw := l.resolve(key, strconv.FormatBool(def), false)
+	v, err := strconv.ParseBool(strings.TrimSpace(raw))
+	if err != nil {
+		l.problem("%s: valor booleano inválido %q", key, raw)
+		return def
+	}
+	return v
+}
+
+func (l *loader) duration(key string, def time.Duration) time.Duration {
+	raw := l.resolve(key, def.String(), false)
+	v, err := time.ParseDuration(strings.TrimSpace(raw))
+	if err != nil {
+		l.problem("%s: duração inválida %q", key, raw)
+		return def
+	}
+	return v
+}
+
+func (l *loader) list(key string, def []string) []string {
+	raw := l.resolve(key, strings.Join(def, ","), false)
+	var items []string
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// masterKey decodifica uma chave AES-256 em base64; vazia devolve nil.
+func (l *loader) masterKey(key, value string) []byte {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(decoded) != 32 {
+		l.problem("%s: a chave deve ter 32 bytes em base64", key)
+		return nil
+	}
+	return decoded
+}
+
+func (l *loader) required(key, value string) {
+	if strings.TrimSpace(value) == "" {
+		l.problem("%s: campo obrigatório", key)
+	}
+}
+
+func (l *loader) port(key string, value int) {
+	if value < 1 || value > 65535 {
+		l.problem("%s: porta deve estar entre 1 e 65535", key)
+	}
+}
+
+func (l *loader) positive(key string, value time.Duration) {
+	if value <= 0 {
+		l.problem("%s: deve ser maior que zero", key)
+	}
+}
+
+func (l *loader) fileExists(key, path string) {
+	if path == "" {
+		return
+	}
+	if _, err := os.Stat(path); err != nil {
+		l.problem("%s: arquivo %q não encontrado", key, path)
+	}
+}
+
+func (l *loader) oneOf(key, value string, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	l.problem("%s: valor %q não permitido, use um de: %s", key, value, strings.Join(allowed, ", "))
+}
+
+func (l *loader) sortedEntries() []entry {
+	entries := make([]entry, len(l.entries))
+	copy(entries, l.entries)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].key < entries[j].key
+	})
+	return entries
+}
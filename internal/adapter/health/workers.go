@@ -0,0 +1,166 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+)
+
+// Workers acompanha os processos em segundo plano. Cada worker registrado
+// deve chamar Beat a cada execução; ele é considerado com falha quando fica
+// mais de três intervalos sem reportar ou falha três vezes seguidas. Falhas
+// isoladas aparecem só no log e nas métricas, para que um erro passageiro
+// não tire todas as réplicas do balanceador.
+type Workers struct {
+	mu      sync.RWMutex
+	workers map[string]*Worker
+}
+
+const maxConsecutiveFailures = 3
+
+type Worker struct {
+	name     string
+	interval time.Duration
+
+	mu                  sync.RWMutex
+	lastBeat            time.Time
+	lastErr             error
+	runs                int
+	failures            int
+	consecutiveFailures int
+}
+
+// WorkerStats é o resumo de um worker exposto nas métricas.
+type WorkerStats struct {
+	Name                string
+	Runs                int
+	Failures            int
+	ConsecutiveFailures int
+	LastBeat            time.Time
+}
+
+func NewWorkers() *Workers {
+	return &Workers{
+		workers: make(map[string]*Worker),
+	}
+}
+
+func (ws *Workers) Register(name string, interval time.Duration) *Worker {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	w := &Worker{
+		name:     name,
+		interval: interval,
+		lastBeat: time.Now(),
+	}
+	ws.workers[name] = w
+	return w
+}
+
+func (w *Worker) Beat(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.lastBeat = time.Now()
+	w.lastErr = err
+	w.runs++
+	if err == nil {
+		w.consecutiveFailures = 0
+		return
+	}
+	w.failures++
+	w.consecutiveFailures++
+}
+
+// Run executa fn a cada intervalo até ctx ser cancelado, registrando o
+// resultado de cada execução.
+func (w *Worker) Run(ctx context.Context, fn func(ctx context.Context) error) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := fn(ctx)
+			if err != nil {
+				utils.Logger(ctx).ErrorContext(ctx, "Erro na execução do worker", "worker", w.name, "error", err.Error())
+			}
+			w.Beat(err)
+		}
+	}
+}
+
+func (w *Worker) check(now time.Time) error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.consecutiveFailures >= maxConsecutiveFailures {
+		return fmt.Errorf("%s: %d falhas seguidas: %w", w.name, w.consecutiveFailures, w.lastErr)
+	}
+	if now.Sub(w.lastBeat) > 3*w.interval {
+		return fmt.Errorf("%s: sem atividade desde %s", w.name, w.lastBeat.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func (w *Worker) stats() WorkerStats {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return WorkerStats{
+		Name:                w.name,
+		Runs:                w.runs,
+		Failures:            w.failures,
+		ConsecutiveFailures: w.consecutiveFailures,
+		LastBeat:            w.lastBeat,
+	}
+}
+
+// Stats devolve o resumo de cada worker, em ordem de nome.
+func (ws *Workers) Stats() []WorkerStats {
+	ws.mu.RLock()
+	stats := make([]WorkerStats, 0, len(ws.workers))
+	for _, w := range ws.workers {
+		stats = append(stats, w.stats())
+	}
+	ws.mu.RUnlock()
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats
+}
+
+func (ws *Workers) Name() string {
+	return "workers"
+}
+
+func (ws *Workers) Check(ctx context.Context) error {
+	ws.mu.RLock()
+	names := make([]string, 0, len(ws.workers))
+	for name := range ws.workers {
+		names = append(names, name)
+	}
+	ws.mu.RUnlock()
+	sort.Strings(names)
+
+	now := time.Now()
+	var errs []error
+	for _, name := range names {
+		ws.mu.RLock()
+		w := ws.workers[name]
+		ws.mu.RUnlock()
+
+		if err := w.check(now); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AgentTokenResponse é a única resposta que contém o token completo.
+type AgentTokenResponse struct {
+	DeviceID uuid.UUID `json:"device_id"`
+	Token    string    `json:"token"`
+}
+
+type AgentConfigResponse struct {
+	DeviceID    uuid.UUID           `json:"device_id"`
+	Version     string              `json:"version"`
+	GeneratedAt time.Time           `json:"generated_at"`
+	Plans       []AgentPlanResponse `json:"plans"`
+}
+
+type AgentPlanResponse struct {
+	ID              uuid.UUID                  `json:"id"`
+	Name            string                     `json:"name"`
+	Compression     bool                       `json:"compression"`
+	Encryption      bool                       `json:"encryption"`
+	Schedule        []AgentScheduleResponse    `json:"schedule"`
+	Sources         []BackupPlanSourceResponse `json:"sources"`
+	RetentionPolicy *RetentionPolicyResponse   `json:"retention_policy"`
+	StorageTarget   *AgentTargetResponse       `json:"storage_target"`
+}
+
+// AgentScheduleResponse: time está no formato HH:MM.
+type AgentScheduleResponse struct {
+	Day        string `json:"day"`
+	Time       string `json:"time"`
+	BackupType string `json:"backup_type"`
+}
+
+type AgentTargetResponse struct {
+	ID          uuid.UUID                 `json:"id"`
+	Name        string                    `json:"name"`
+	Kind        string                    `json:"kind"`
+	Endpoint    string                    `json:"endpoint"`
+	Credentials *AgentCredentialsResponse `json:"credentials"`
+}
+
+type AgentCredentialsResponse struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
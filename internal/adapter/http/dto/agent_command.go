@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateAgentCommandRequest: backup_plan_id vale para run_backup, verify e
+// restore_test; agent_version só para update_agent. Sem ttl_seconds vale a
+// validade padrão configurada.
+type CreateAgentCommandRequest struct {
+	Type         string     `json:"type" validate:"required,oneof=run_backup verify restore_test update_agent"`
+	BackupPlanID *uuid.UUID `json:"backup_plan_id"`
+	AgentVersion string     `json:"agent_version" validate:"max=50"`
+	TTLSeconds   int        `json:"ttl_seconds" validate:"omitempty,min=60"`
+}
+
+type AgentCommandResultRequest struct {
+	Status string `json:"status" validate:"required,oneof=succeeded failed"`
+	Result string `json:"result" validate:"max=4096"`
+}
+
+type AgentCommandResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	DeviceID       uuid.UUID  `json:"device_id"`
+	Type           string     `json:"type"`
+	BackupPlanID   *uuid.UUID `json:"backup_plan_id"`
+	AgentVersion   string     `json:"agent_version,omitempty"`
+	Status         string     `json:"status"`
+	Result         string     `json:"result"`
+	RequestedBy    *uuid.UUID `json:"requested_by"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at"`
+	CompletedAt    *time.Time `json:"completed_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
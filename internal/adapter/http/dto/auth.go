@@ -4,3 +4,17 @@ type LoginRequest struct {
 	Username string `json:"username" validate:"required,min=3,max=50,alphanum"`
 	Password string `json:"password" validate:"required,min=6"`
 }
+
+type MFAChallengeResponse struct {
+	MFARequired    bool   `json:"mfa_required"`
+	ChallengeToken string `json:"challenge_token"`
+}
+
+type OIDCLinkResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+}
+
+type MFAVerifyRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	Code           string `json:"code" validate:"required,min=6,max=20"`
+}
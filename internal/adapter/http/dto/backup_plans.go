@@ -1,40 +1,123 @@
 package dto
 
 import (
+	"encoding/json"
 	"math/big"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// BackupPlanRequest: retention_policy_id e storage_target_id são opcionais e
+// precisam ser do mesmo cliente do dispositivo (o destino pode ser
+// compartilhado); na alteração, null remove o vínculo e a ausência do campo
+// mantém o atual. Force grava o plano mesmo que ultrapasse a capacidade do
+// destino. Sem compression/encryption o plano é comprimido e não cifrado.
 type BackupPlanRequest struct {
-	Name            string                     `json:"name" validate:"required,min=3,max=50"`
-	BackupSizeBytes *big.Int                   `json:"backup_size_bytes" validate:"required"`
-	DeviceID        uuid.UUID                  `json:"device_id" validate:"required"`
-	WeekDays        []BackupPlanWeekDayRequest `json:"week_days" validate:"required"`
+	Name              string                     `json:"name" validate:"required,min=3,max=50"`
+	BackupSizeBytes   *big.Int                   `json:"backup_size_bytes" validate:"required"`
+	DeviceID          uuid.UUID                  `json:"device_id" validate:"required"`
+	RetentionPolicyID NullableUUID               `json:"retention_policy_id"`
+	StorageTargetID   NullableUUID               `json:"storage_target_id"`
+	Force             bool                       `json:"force"`
+	Compression       *bool                      `json:"compression"`
+	Encryption        *bool                      `json:"encryption"`
+	WeekDays          []BackupPlanWeekDayRequest `json:"week_days" validate:"required,min=1,dive"`
+	Sources           []BackupPlanSourceRequest  `json:"sources" validate:"required,min=1,dive"`
 }
 
+// NullableUUID distingue o campo ausente (Set falso) do null explícito (Set
+// verdadeiro e Value nil).
+type NullableUUID struct {
+	Set   bool
+	Value *uuid.UUID
+}
+
+func (n *NullableUUID) UnmarshalJSON(data []byte) error {
+	n.Set = true
+	n.Value = nil
+	if string(data) == "null" {
+		return nil
+	}
+
+	var id uuid.UUID
+	if err := json.Unmarshal(data, &id); err != nil {
+		return err
+	}
+	n.Value = &id
+	return nil
+}
+
+// UpdateBackupPlanRequest: campos ausentes mantêm o valor atual; week_days e
+// sources, quando informados, substituem a lista inteira.
+type UpdateBackupPlanRequest struct {
+	Name              string                           `json:"name" validate:"omitempty,min=3,max=50"`
+	BackupSizeBytes   *big.Int                         `json:"backup_size_bytes"`
+	DeviceID          uuid.UUID                        `json:"device_id"`
+	RetentionPolicyID NullableUUID                     `json:"retention_policy_id"`
+	StorageTargetID   NullableUUID                     `json:"storage_target_id"`
+	Force             bool                             `json:"force"`
+	Compression       *bool                            `json:"compression"`
+	Encryption        *bool                            `json:"encryption"`
+	WeekDays          []UpdateBackupPlanWeekDayRequest `json:"week_days" validate:"omitempty,dive"`
+	Sources           []BackupPlanSourceRequest        `json:"sources" validate:"omitempty,dive"`
+}
+
+// BackupPlanWeekDayRequest: sem backup_type o horário faz backup completo. O
+// dia é o nome em inglês, sem diferenciar maiúsculas.
 type BackupPlanWeekDayRequest struct {
-	Day          string    `json:"day" validate:"required"`
-	TimeDay      time.Time `json:"time_day" validate:"required,datetime"`
-	BackupPlanID uuid.UUID `json:"backup_plan_id" validate:"required"`
+	Day          string    `json:"day" validate:"required,max=20"`
+	TimeDay      time.Time `json:"time_day" validate:"required"`
+	BackupType   string    `json:"backup_type" validate:"omitempty,oneof=full incremental differential"`
+	BackupPlanID uuid.UUID `json:"backup_plan_id"`
+}
+
+// UpdateBackupPlanWeekDayRequest: campos ausentes herdam o horário que está
+// na mesma posição.
+type UpdateBackupPlanWeekDayRequest struct {
+	Day        string    `json:"day" validate:"omitempty,max=20"`
+	TimeDay    time.Time `json:"time_day"`
+	BackupType string    `json:"backup_type" validate:"omitempty,oneof=full incremental differential"`
+}
+
+type BackupPlanSourceRequest struct {
+	Kind     string   `json:"kind" validate:"required,oneof=path volume database"`
+	Location string   `json:"location" validate:"required,max=1024"`
+	Include  []string `json:"include" validate:"dive,required,max=255"`
+	Exclude  []string `json:"exclude" validate:"dive,required,max=255"`
 }
 
 type BackupPlanResponse struct {
-	ID              uuid.UUID                   `json:"id"`
-	Name            string                      `json:"name"`
-	BackupSizeBytes *big.Int                    `json:"backup_size_bytes"`
-	DeviceID        uuid.UUID                   `json:"device_id"`
-	CreatedAt       time.Time                   `json:"created_at"`
-	UpdatedAt       time.Time                   `json:"updated_at"`
-	WeekDays        []BackupPlanWeekDayResponse `json:"week_days"`
+	ID                uuid.UUID                   `json:"id"`
+	Name              string                      `json:"name"`
+	BackupSizeBytes   *big.Int                    `json:"backup_size_bytes"`
+	DeviceID          uuid.UUID                   `json:"device_id"`
+	RetentionPolicyID *uuid.UUID                  `json:"retention_policy_id"`
+	StorageTargetID   *uuid.UUID                  `json:"storage_target_id"`
+	Compression       bool                        `json:"compression"`
+	Encryption        bool                        `json:"encryption"`
+	CreatedAt         time.Time                   `json:"created_at"`
+	UpdatedAt         time.Time                   `json:"updated_at"`
+	WeekDays          []BackupPlanWeekDayResponse `json:"week_days"`
+	Sources           []BackupPlanSourceResponse  `json:"sources"`
+	// Verification é null quando o plano não tem agenda de verificação.
+	Verification *VerificationStatusResponse `json:"verification"`
 }
 
 type BackupPlanWeekDayResponse struct {
 	ID           uuid.UUID `json:"id"`
 	Day          string    `json:"day"`
 	TimeDay      time.Time `json:"time_day"`
+	BackupType   string    `json:"backup_type"`
 	BackupPlanID uuid.UUID `json:"backup_plan_id"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 }
+
+type BackupPlanSourceResponse struct {
+	ID       uuid.UUID `json:"id"`
+	Kind     string    `json:"kind"`
+	Location string    `json:"location"`
+	Include  []string  `json:"include"`
+	Exclude  []string  `json:"exclude"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+type TOTPEnrollmentResponse struct {
+	Secret        string   `json:"secret"`
+	URI           string   `json:"otpauth_uri"`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type MFACodeRequest struct {
+	Code string `json:"code" validate:"required,min=6,max=20"`
+}
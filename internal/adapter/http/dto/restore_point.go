@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReportRestorePointsRequest: o id de cada ponto é gerado pelo agente e
+// created_at é o momento do backup.
+type ReportRestorePointsRequest struct {
+	BackupPlanID  uuid.UUID                     `json:"backup_plan_id" validate:"required"`
+	RestorePoints []ReportedRestorePointRequest `json:"restore_points" validate:"required,min=1,max=100,dive"`
+}
+
+type ReportedRestorePointRequest struct {
+	ID        uuid.UUID `json:"id" validate:"required"`
+	CreatedAt time.Time `json:"created_at" validate:"required"`
+	Type      string    `json:"type" validate:"required,oneof=full incremental differential"`
+	SizeBytes *big.Int  `json:"size_bytes" validate:"required"`
+	Checksum  string    `json:"checksum" validate:"max=255"`
+	Location  string    `json:"location" validate:"required,max=1024"`
+}
+
+type RestorePointResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	BackupPlanID uuid.UUID  `json:"backup_plan_id"`
+	Type         string     `json:"type"`
+	SizeBytes    *big.Int   `json:"size_bytes"`
+	Checksum     string     `json:"checksum"`
+	Location     string     `json:"location"`
+	CreatedAt    time.Time  `json:"created_at"`
+	ExpiresAt    *time.Time `json:"expires_at"`
+	ReportedAt   time.Time  `json:"reported_at"`
+}
+
+// RestorePointReportResponse lista os pontos vencidos do plano, que o agente
+// deve apagar do destino e depois remover do catálogo.
+type RestorePointReportResponse struct {
+	Expired []RestorePointResponse `json:"expired"`
+}
+
+// SizeHistoryResponse: baseline é null enquanto o plano não tiver backups
+// anteriores suficientes do mesmo tipo; lower e upper delimitam a faixa fora
+// da qual o tamanho gera alerta.
+type SizeHistoryResponse struct {
+	RestorePointID uuid.UUID             `json:"restore_point_id"`
+	Type           string                `json:"type"`
+	SizeBytes      *big.Int              `json:"size_bytes"`
+	CreatedAt      time.Time             `json:"created_at"`
+	Baseline       *SizeBaselineResponse `json:"baseline"`
+	Anomaly        *SizeAnomalyResponse  `json:"anomaly"`
+}
+
+type SizeBaselineResponse struct {
+	Samples int     `json:"samples"`
+	Median  float64 `json:"median"`
+	MAD     float64 `json:"mad"`
+	Lower   float64 `json:"lower"`
+	Upper   float64 `json:"upper"`
+}
+
+type SizeAnomalyResponse struct {
+	Kind     string `json:"kind"`
+	Severity string `json:"severity"`
+}
+
+type SizeAlertResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	BackupPlanID   uuid.UUID  `json:"backup_plan_id"`
+	RestorePointID *uuid.UUID `json:"restore_point_id"`
+	Type           string     `json:"type"`
+	Kind           string     `json:"kind"`
+	Severity       string     `json:"severity"`
+	SizeBytes      int64      `json:"size_bytes"`
+	BaselineMedian int64      `json:"baseline_median"`
+	BaselineMAD    int64      `json:"baseline_mad"`
+	PointCreatedAt time.Time  `json:"point_created_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
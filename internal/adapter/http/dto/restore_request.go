@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateRestoreRequestRequest: sem target_device_id a restauração vai para o
+// dispositivo do plano; sem target_path, para o local original.
+type CreateRestoreRequestRequest struct {
+	BackupPlanID   uuid.UUID  `json:"backup_plan_id" validate:"required"`
+	RestorePointID uuid.UUID  `json:"restore_point_id" validate:"required"`
+	TargetDeviceID *uuid.UUID `json:"target_device_id"`
+	TargetPath     string     `json:"target_path" validate:"max=1024"`
+	Reason         string     `json:"reason" validate:"required,min=3,max=1024"`
+}
+
+// ReviewRestoreRequestRequest: note é obrigatória na rejeição.
+type ReviewRestoreRequestRequest struct {
+	Note string `json:"note" validate:"max=1024"`
+}
+
+type RestoreResultRequest struct {
+	Status string `json:"status" validate:"required,oneof=completed failed"`
+	Result string `json:"result" validate:"max=4096"`
+}
+
+type RestoreRequestResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	BackupPlanID   uuid.UUID  `json:"backup_plan_id"`
+	RestorePointID uuid.UUID  `json:"restore_point_id"`
+	TargetDeviceID uuid.UUID  `json:"target_device_id"`
+	TargetPath     string     `json:"target_path"`
+	Reason         string     `json:"reason"`
+	Status         string     `json:"status"`
+	RequestedBy    *uuid.UUID `json:"requested_by"`
+	ReviewedBy     *uuid.UUID `json:"reviewed_by"`
+	ReviewNote     string     `json:"review_note"`
+	ReviewedAt     *time.Time `json:"reviewed_at"`
+	StartedAt      *time.Time `json:"started_at"`
+	CompletedAt    *time.Time `json:"completed_at"`
+	Result         string     `json:"result"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateRetentionPolicyRequest struct {
+	CustomerID  uuid.UUID `json:"customer_id" validate:"required"`
+	Name        string    `json:"name" validate:"required,min=3,max=50"`
+	KeepLast    int       `json:"keep_last" validate:"gte=0"`
+	KeepDaily   int       `json:"keep_daily" validate:"gte=0"`
+	KeepWeekly  int       `json:"keep_weekly" validate:"gte=0"`
+	KeepMonthly int       `json:"keep_monthly" validate:"gte=0"`
+	KeepYearly  int       `json:"keep_yearly" validate:"gte=0"`
+	MaxAgeDays  int       `json:"max_age_days" validate:"gte=0"`
+}
+
+// UpdateRetentionPolicyRequest substitui todas as regras da política; o
+// cliente não pode ser alterado.
+type UpdateRetentionPolicyRequest struct {
+	Name        string `json:"name" validate:"omitempty,min=3,max=50"`
+	KeepLast    int    `json:"keep_last" validate:"gte=0"`
+	KeepDaily   int    `json:"keep_daily" validate:"gte=0"`
+	KeepWeekly  int    `json:"keep_weekly" validate:"gte=0"`
+	KeepMonthly int    `json:"keep_monthly" validate:"gte=0"`
+	KeepYearly  int    `json:"keep_yearly" validate:"gte=0"`
+	MaxAgeDays  int    `json:"max_age_days" validate:"gte=0"`
+}
+
+type RetentionPolicyResponse struct {
+	ID          uuid.UUID `json:"id"`
+	CustomerID  uuid.UUID `json:"customer_id"`
+	Name        string    `json:"name"`
+	KeepLast    int       `json:"keep_last"`
+	KeepDaily   int       `json:"keep_daily"`
+	KeepWeekly  int       `json:"keep_weekly"`
+	KeepMonthly int       `json:"keep_monthly"`
+	KeepYearly  int       `json:"keep_yearly"`
+	MaxAgeDays  int       `json:"max_age_days"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type RetentionEvaluateRequest struct {
+	RestorePoints []RestorePointRequest `json:"restore_points" validate:"required,dive"`
+}
+
+// RestorePointRequest: sem type o ponto é tratado como backup completo.
+type RestorePointRequest struct {
+	ID        uuid.UUID `json:"id" validate:"required"`
+	CreatedAt time.Time `json:"created_at" validate:"required"`
+	Type      string    `json:"type" validate:"omitempty,oneof=full incremental differential"`
+}
+
+type RetentionDecisionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Keep      bool      `json:"keep"`
+	Reasons   []string  `json:"reasons"`
+}
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateStorageTargetRequest: sem customer_id o destino é compartilhado.
+// As credenciais são gravadas cifradas e nunca voltam nas respostas.
+type CreateStorageTargetRequest struct {
+	CustomerID    *uuid.UUID                       `json:"customer_id"`
+	Name          string                           `json:"name" validate:"required,min=3,max=50"`
+	Kind          string                           `json:"kind" validate:"required,oneof=local nas_smb s3 tape"`
+	Endpoint      string                           `json:"endpoint" validate:"required,max=255"`
+	CapacityBytes *big.Int                         `json:"capacity_bytes" validate:"required"`
+	Credentials   *StorageTargetCredentialsRequest `json:"credentials"`
+}
+
+type UpdateStorageTargetRequest struct {
+	Name          string                           `json:"name" validate:"omitempty,min=3,max=50"`
+	Kind          string                           `json:"kind" validate:"omitempty,oneof=local nas_smb s3 tape"`
+	Endpoint      string                           `json:"endpoint" validate:"omitempty,max=255"`
+	CapacityBytes *big.Int                         `json:"capacity_bytes"`
+	Credentials   *StorageTargetCredentialsRequest `json:"credentials"`
+}
+
+type StorageTargetCredentialsRequest struct {
+	Username string `json:"username" validate:"max=255"`
+	Password string `json:"password" validate:"required,max=1024"`
+}
+
+type StorageTargetResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	CustomerID     *uuid.UUID `json:"customer_id"`
+	Name           string     `json:"name"`
+	Kind           string     `json:"kind"`
+	Endpoint       string     `json:"endpoint"`
+	CapacityBytes  *big.Int   `json:"capacity_bytes"`
+	HasCredentials bool       `json:"has_credentials"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+type StorageTargetUsageResponse struct {
+	StorageTargetID uuid.UUID                        `json:"storage_target_id"`
+	CapacityBytes   *big.Int                         `json:"capacity_bytes"`
+	ProjectedBytes  *big.Int                         `json:"projected_bytes"`
+	AvailableBytes  *big.Int                         `json:"available_bytes"`
+	UsagePercent    float64                          `json:"usage_percent"`
+	Exceeded        bool                             `json:"exceeded"`
+	Plans           []StorageTargetPlanUsageResponse `json:"plans"`
+}
+
+type StorageTargetPlanUsageResponse struct {
+	BackupPlanID    uuid.UUID `json:"backup_plan_id"`
+	Name            string    `json:"name"`
+	BackupSizeBytes *big.Int  `json:"backup_size_bytes"`
+	RetainedCopies  int       `json:"retained_copies"`
+	ProjectedBytes  *big.Int  `json:"projected_bytes"`
+}
+
+type SecretRotationResponse struct {
+	KeyID   string `json:"key_id"`
+	Rotated int    `json:"rotated"`
+	Failed  int    `json:"failed"`
+}
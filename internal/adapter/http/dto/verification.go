@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VerificationScheduleRequest: a cada interval_days os backups do plano são
+// verificados; a verificação fica atrasada grace_days após o vencimento.
+type VerificationScheduleRequest struct {
+	Kind         string `json:"kind" validate:"required,oneof=verify restore_test"`
+	IntervalDays int    `json:"interval_days" validate:"required,min=1,max=365"`
+	GraceDays    int    `json:"grace_days" validate:"min=0,max=90"`
+}
+
+type VerificationScheduleResponse struct {
+	BackupPlanID uuid.UUID `json:"backup_plan_id"`
+	Kind         string    `json:"kind"`
+	IntervalDays int       `json:"interval_days"`
+	GraceDays    int       `json:"grace_days"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type VerificationStatusResponse struct {
+	BackupPlanID   uuid.UUID  `json:"backup_plan_id"`
+	BackupPlanName string     `json:"backup_plan_name,omitempty"`
+	Kind           string     `json:"kind"`
+	IntervalDays   int        `json:"interval_days"`
+	LastVerifiedAt *time.Time `json:"last_verified_at"`
+	DueAt          time.Time  `json:"due_at"`
+	Overdue        bool       `json:"overdue"`
+}
+
+// VerificationResultRequest: restore_point_id é o ponto verificado, que
+// precisa ser do plano da verificação e estar válido.
+type VerificationResultRequest struct {
+	RestorePointID uuid.UUID `json:"restore_point_id" validate:"required"`
+	Status         string    `json:"status" validate:"required,oneof=succeeded failed"`
+	Result         string    `json:"result" validate:"max=4096"`
+}
+
+type VerificationJobResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	BackupPlanID   uuid.UUID  `json:"backup_plan_id"`
+	Kind           string     `json:"kind"`
+	Status         string     `json:"status"`
+	ScheduledAt    time.Time  `json:"scheduled_at"`
+	RestorePointID *uuid.UUID `json:"restore_point_id"`
+	Result         string     `json:"result"`
+	CompletedAt    *time.Time `json:"completed_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
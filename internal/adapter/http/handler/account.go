@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/go-playground/validator/v10"
+)
+
+type AccountHandler struct {
+	validator *validator.Validate
+	svc       port.AccountService
+}
+
+func NewAccountHandler(svc port.AccountService) *AccountHandler {
+	validator := validator.New(validator.WithRequiredStructEnabled())
+	return &AccountHandler{
+		validator,
+		svc,
+	}
+}
+
+func (ah *AccountHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req dto.ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := ah.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	err := ah.svc.ForgotPassword(r.Context(), req.Email)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusAccepted, translate(r, "account.forgot_password"), nil, nil, nil)
+}
+
+func (ah *AccountHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req dto.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := ah.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	err := ah.svc.ResetPassword(r.Context(), req.Token, req.Password)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "account.password_reset"), nil, nil, nil)
+}
+
+func (ah *AccountHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req dto.VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := ah.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	err := ah.svc.VerifyEmail(r.Context(), req.Token)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "account.email_verified"), nil, nil, nil)
+}
+
+func (ah *AccountHandler) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	var req dto.ResendVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := ah.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	err := ah.svc.ResendEmailVerification(r.Context(), req.Email)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusAccepted, translate(r, "account.verification_sent"), nil, nil, nil)
+}
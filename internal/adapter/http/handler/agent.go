@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/middlewares"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const agentScheduleTimeFormat = "15:04"
+
+type AgentHandler struct {
+	svc port.AgentService
+}
+
+func NewAgentHandler(svc port.AgentService) *AgentHandler {
+	return &AgentHandler{
+		svc,
+	}
+}
+
+func (ah *AgentHandler) IssueAgentToken(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	token, err := ah.svc.IssueAgentToken(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	res := dto.AgentTokenResponse{
+		DeviceID: id,
+		Token:    token,
+	}
+
+	response.JSON(w, http.StatusCreated, translate(r, "agent.token_issued"), res, nil, nil)
+}
+
+func (ah *AgentHandler) RevokeAgentToken(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	err = ah.svc.RevokeAgentToken(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetAgentConfig devolve a versão da configuração no ETag e responde 304
+// quando o agente já tem essa versão (If-None-Match).
+func (ah *AgentHandler) GetAgentConfig(w http.ResponseWriter, r *http.Request) {
+	device, ok := middlewares.Device(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrUnauthorized)
+		return
+	}
+
+	config, err := ah.svc.GetAgentConfig(r.Context(), device.ID)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	etag := `"` + config.Version + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-store")
+
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	res := dto.AgentConfigResponse{
+		DeviceID:    config.DeviceID,
+		Version:     config.Version,
+		GeneratedAt: config.GeneratedAt,
+		Plans:       make([]dto.AgentPlanResponse, len(config.Plans)),
+	}
+
+	for i, plan := range config.Plans {
+		res.Plans[i] = agentPlanResponse(&plan)
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "agent.config"), res, nil, nil)
+}
+
+func agentPlanResponse(plan *domain.AgentPlan) dto.AgentPlanResponse {
+	backupPlan := plan.BackupPlan
+
+	res := dto.AgentPlanResponse{
+		ID:          backupPlan.ID,
+		Name:        backupPlan.Name,
+		Compression: *backupPlan.Compression,
+		Encryption:  *backupPlan.Encryption,
+		Schedule:    make([]dto.AgentScheduleResponse, len(backupPlan.WeekDays)),
+		Sources:     backupPlanSourcesResponse(backupPlan.Sources),
+	}
+
+	for i, wd := range backupPlan.WeekDays {
+		res.Schedule[i] = dto.AgentScheduleResponse{
+			Day:        wd.Day,
+			Time:       wd.TimeDay.Format(agentScheduleTimeFormat),
+			BackupType: string(wd.BackupType),
+		}
+	}
+
+	if plan.RetentionPolicy != nil {
+		policy := retentionPolicyResponse(plan.RetentionPolicy)
+		res.RetentionPolicy = &policy
+	}
+
+	if target := plan.StorageTarget; target != nil {
+		res.StorageTarget = &dto.AgentTargetResponse{
+			ID:       target.ID,
+			Name:     target.Name,
+			Kind:     string(target.Kind),
+			Endpoint: target.Endpoint,
+		}
+
+		if credentials := plan.TargetCredentials; credentials != nil {
+			res.StorageTarget.Credentials = &dto.AgentCredentialsResponse{
+				Username: credentials.Username,
+				Password: credentials.Password,
+			}
+		}
+	}
+
+	return res
+}
+
+// etagMatches segue a comparação fraca do If-None-Match: aceita "*", listas
+// separadas por vírgula e validadores com prefixo W/.
+func etagMatches(header, etag string) bool {
+	for candidate := range strings.SplitSeq(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
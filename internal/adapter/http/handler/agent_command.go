@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/middlewares"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type AgentCommandHandler struct {
+	validator *validator.Validate
+	svc       port.AgentCommandService
+}
+
+func NewAgentCommandHandler(svc port.AgentCommandService) *AgentCommandHandler {
+	validator := validator.New(validator.WithRequiredStructEnabled())
+	return &AgentCommandHandler{
+		validator,
+		svc,
+	}
+}
+
+func (ach *AgentCommandHandler) CreateAgentCommand(w http.ResponseWriter, r *http.Request) {
+	payload, ok := middlewares.Payload(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+		return
+	}
+
+	deviceID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	var req dto.CreateAgentCommandRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := ach.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	command := &domain.AgentCommand{
+		ID:           uuid.New(),
+		DeviceID:     deviceID,
+		Type:         domain.AgentCommandType(req.Type),
+		BackupPlanID: req.BackupPlanID,
+		AgentVersion: req.AgentVersion,
+		RequestedBy:  &payload.UserID,
+	}
+
+	err = ach.svc.CreateAgentCommand(r.Context(), command, time.Duration(req.TTLSeconds)*time.Second)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, translate(r, "agent_command.created"), agentCommandResponse(command), nil, nil)
+}
+
+func (ach *AgentCommandHandler) GetAgentCommand(w http.ResponseWriter, r *http.Request) {
+	deviceID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "command_id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	command, err := ach.svc.GetAgentCommand(r.Context(), deviceID, id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "agent_command.found"), agentCommandResponse(command), nil, nil)
+}
+
+func (ach *AgentCommandHandler) ListAgentCommands(w http.ResponseWriter, r *http.Request) {
+	deviceID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	pageStr := r.URL.Query().Get("page")
+	limitStr := r.URL.Query().Get("limit")
+
+	if pageStr == "" || limitStr == "" {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	page, err := strconv.Atoi(pageStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	commands, err := ach.svc.ListAgentCommands(r.Context(), deviceID, page, limit)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "agent_command.list"), agentCommandsResponse(commands), nil, nil)
+}
+
+// FetchAgentCommands aceita wait em segundos para aguardar novos comandos
+// (long-poll) em vez de responder imediatamente com a lista vazia.
+func (ach *AgentCommandHandler) FetchAgentCommands(w http.ResponseWriter, r *http.Request) {
+	device, ok := middlewares.Device(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrUnauthorized)
+		return
+	}
+
+	var wait int
+	if waitStr := r.URL.Query().Get("wait"); waitStr != "" {
+		var err error
+		wait, err = strconv.Atoi(waitStr)
+		if err != nil || wait < 0 {
+			response.Error(w, r, domain.ErrValidation.WithDetails(map[string]string{
+				"wait": i18n.T(i18n.FromContext(r.Context()), "validation.numeric", "wait"),
+			}))
+			return
+		}
+	}
+
+	commands, err := ach.svc.FetchAgentCommands(r.Context(), device.ID, time.Duration(wait)*time.Second)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "agent_command.list"), agentCommandsResponse(commands), nil, nil)
+}
+
+func (ach *AgentCommandHandler) AcknowledgeAgentCommand(w http.ResponseWriter, r *http.Request) {
+	device, ok := middlewares.Device(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrUnauthorized)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	command, err := ach.svc.AcknowledgeAgentCommand(r.Context(), device.ID, id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "agent_command.acknowledged"), agentCommandResponse(command), nil, nil)
+}
+
+func (ach *AgentCommandHandler) CompleteAgentCommand(w http.ResponseWriter, r *http.Request) {
+	device, ok := middlewares.Device(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrUnauthorized)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	var req dto.AgentCommandResultRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := ach.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	success := domain.AgentCommandStatus(req.Status) == domain.AgentCommandSucceeded
+	command, err := ach.svc.CompleteAgentCommand(r.Context(), device.ID, id, success, req.Result)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "agent_command.completed"), agentCommandResponse(command), nil, nil)
+}
+
+func agentCommandResponse(command *domain.AgentCommand) dto.AgentCommandResponse {
+	return dto.AgentCommandResponse{
+		ID:             command.ID,
+		DeviceID:       command.DeviceID,
+		Type:           string(command.Type),
+		BackupPlanID:   command.BackupPlanID,
+		AgentVersion:   command.AgentVersion,
+		Status:         string(command.Status),
+		Result:         command.Result,
+		RequestedBy:    command.RequestedBy,
+		ExpiresAt:      command.ExpiresAt,
+		AcknowledgedAt: command.AcknowledgedAt,
+		CompletedAt:    command.CompletedAt,
+		CreatedAt:      command.CreatedAt,
+		UpdatedAt:      command.UpdatedAt,
+	}
+}
+
+func agentCommandsResponse(commands []domain.AgentCommand) []dto.AgentCommandResponse {
+	list := make([]dto.AgentCommandResponse, 0, len(commands))
+	for _, command := range commands {
+		list = append(list, agentCommandResponse(&command))
+	}
+	return list
+}
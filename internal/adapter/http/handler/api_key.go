@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/middlewares"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type APIKeyHandler struct {
+	validator *validator.Validate
+	svc       port.APIKeyService
+}
+
+func NewAPIKeyHandler(svc port.APIKeyService) *APIKeyHandler {
+	validator := validator.New(validator.WithRequiredStructEnabled())
+	return &APIKeyHandler{
+		validator,
+		svc,
+	}
+}
+
+func (akh *APIKeyHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	payload, ok := middlewares.Payload(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+		return
+	}
+
+	var req dto.CreateAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := akh.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	key := domain.APIKey{
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	}
+
+	rawKey, err := akh.svc.CreateAPIKey(r.Context(), payload, &key)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	res := dto.CreatedAPIKeyResponse{
+		APIKeyResponse: apiKeyResponse(&key),
+		Key:            rawKey,
+	}
+
+	response.JSON(w, http.StatusCreated, translate(r, "api_key.created"), res, nil, nil)
+}
+
+func (akh *APIKeyHandler) ListMyAPIKeys(w http.ResponseWriter, r *http.Request) {
+	payload, ok := middlewares.Payload(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+		return
+	}
+
+	keys, err := akh.svc.ListUserAPIKeys(r.Context(), payload.UserID)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	list := make([]dto.APIKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, apiKeyResponse(&key))
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "api_key.list"), list, nil, nil)
+}
+
+func (akh *APIKeyHandler) RevokeMyAPIKey(w http.ResponseWriter, r *http.Request) {
+	payload, ok := middlewares.Payload(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	err = akh.svc.RevokeUserAPIKey(r.Context(), payload.UserID, id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, translate(r, "api_key.revoked"), nil, nil, nil)
+}
+
+func (akh *APIKeyHandler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	pageStr := r.URL.Query().Get("page")
+	limitStr := r.URL.Query().Get("limit")
+
+	if pageStr == "" || limitStr == "" {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	page, err := strconv.Atoi(pageStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	keys, err := akh.svc.ListAPIKeys(r.Context(), page, limit)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	list := make([]dto.APIKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, apiKeyResponse(&key))
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "api_key.list"), list, nil, nil)
+}
+
+func (akh *APIKeyHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	err = akh.svc.RevokeAPIKey(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, translate(r, "api_key.revoked"), nil, nil, nil)
+}
+
+func apiKeyResponse(key *domain.APIKey) dto.APIKeyResponse {
+	return dto.APIKeyResponse{
+		ID:         key.ID,
+		UserID:     key.UserID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
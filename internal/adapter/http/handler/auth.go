@@ -9,6 +9,7 @@ import (
 	"github.com/go-playground/validator/v10"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
 )
@@ -29,22 +30,53 @@ func NewAuthHandler(svc port.AuthService) *AuthHandler {
 func (ah *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	var req dto.LoginRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.JSON(w, http.StatusBadRequest, "JSON inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidJSON)
 		return
 	}
 	defer r.Body.Close()
 
 	if err := ah.validator.Struct(req); err != nil {
-		errorsMap := utils.ValidationErrorsToMap(err)
-		response.JSON(w, http.StatusBadRequest, "Dados de entrada inválidos", nil, domain.ErrBadRequest.Error(), errorsMap)
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
 		return
 	}
 
-	token, err := ah.svc.Login(r.Context(), req.Username, req.Password)
+	result, err := ah.svc.Login(r.Context(), req.Username, req.Password)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
-	response.JSON(w, http.StatusOK, "Autenticado com sucesso", token, nil, nil)
+	if result.MFARequired {
+		res := dto.MFAChallengeResponse{
+			MFARequired:    true,
+			ChallengeToken: result.ChallengeToken,
+		}
+
+		response.JSON(w, http.StatusOK, translate(r, "auth.mfa_required"), res, nil, nil)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "auth.login"), result.AccessToken, nil, nil)
+}
+
+func (ah *AuthHandler) VerifyMFA(w http.ResponseWriter, r *http.Request) {
+	var req dto.MFAVerifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := ah.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	token, err := ah.svc.VerifyMFA(r.Context(), req.ChallengeToken, req.Code)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "auth.login"), token, nil, nil)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
 	"github.com/go-chi/chi/v5"
@@ -31,53 +32,58 @@ func NewBackupPlanHandler(svc port.BackupPlanService) *BackupPlanHandler {
 func (bph *BackupPlanHandler) CreateBackupPlan(w http.ResponseWriter, r *http.Request) {
 	var req dto.BackupPlanRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.JSON(w, http.StatusBadRequest, "JSON inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidJSON)
 		return
 	}
 	defer r.Body.Close()
 
 	if err := bph.validator.Struct(req); err != nil {
-		errorsMap := utils.ValidationErrorsToMap(err)
-		response.JSON(w, http.StatusBadRequest, "Dados de entrada inválidos", nil, domain.ErrBadRequest.Error(), errorsMap)
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
 		return
 	}
 
 	backupPlan := &domain.BackupPlan{
-		ID:              uuid.New(),
-		Name:            req.Name,
-		BackupSizeBytes: req.BackupSizeBytes,
-		DeviceID:        req.DeviceID,
+		ID:                uuid.New(),
+		Name:              req.Name,
+		BackupSizeBytes:   req.BackupSizeBytes,
+		DeviceID:          req.DeviceID,
+		RetentionPolicyID: req.RetentionPolicyID.Value,
+		StorageTargetID:   req.StorageTargetID.Value,
+		Compression:       req.Compression,
+		Encryption:        req.Encryption,
+		Sources:           backupPlanSources(req.Sources),
 	}
 
 	backupPlan.WeekDays = make([]domain.BackupPlanWeekDay, len(req.WeekDays))
 	for i, wdReq := range req.WeekDays {
 		backupPlan.WeekDays[i] = domain.BackupPlanWeekDay{
 			ID:           uuid.New(),
-			Day:          wdReq.Day,
+			Day:          normalizeWeekDay(wdReq.Day),
 			TimeDay:      wdReq.TimeDay,
+			BackupType:   utils.Coalesce(domain.BackupType(wdReq.BackupType), domain.BackupTypeFull),
 			BackupPlanID: backupPlan.ID,
 		}
 	}
 
-	err := bph.svc.CreateBackupPlan(r.Context(), backupPlan)
+	err := bph.svc.CreateBackupPlan(r.Context(), backupPlan, req.Force)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
-	response.JSON(w, http.StatusCreated, "Plano de backup criado com sucesso", nil, nil, nil)
+	response.JSON(w, http.StatusCreated, translate(r, "backup_plan.created"), nil, nil, nil)
 }
 
 func (bph *BackupPlanHandler) GetBackupPlan(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "UUID inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidUUID)
 		return
 	}
 
 	backupPlan, err := bph.svc.GetBackupPlan(r.Context(), id)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
@@ -87,6 +93,7 @@ func (bph *BackupPlanHandler) GetBackupPlan(w http.ResponseWriter, r *http.Reque
 			ID:           wd.ID,
 			Day:          wd.Day,
 			TimeDay:      wd.TimeDay,
+			BackupType:   string(wd.BackupType),
 			CreatedAt:    wd.CreatedAt,
 			UpdatedAt:    wd.UpdatedAt,
 			BackupPlanID: wd.BackupPlanID,
@@ -94,16 +101,21 @@ func (bph *BackupPlanHandler) GetBackupPlan(w http.ResponseWriter, r *http.Reque
 	}
 
 	res := dto.BackupPlanResponse{
-		ID:              backupPlan.ID,
-		Name:            backupPlan.Name,
-		BackupSizeBytes: backupPlan.BackupSizeBytes,
-		DeviceID:        backupPlan.DeviceID,
-		CreatedAt:       backupPlan.CreatedAt,
-		UpdatedAt:       backupPlan.UpdatedAt,
-		WeekDays:        weekDays,
+		ID:                backupPlan.ID,
+		Name:              backupPlan.Name,
+		BackupSizeBytes:   backupPlan.BackupSizeBytes,
+		DeviceID:          backupPlan.DeviceID,
+		RetentionPolicyID: backupPlan.RetentionPolicyID,
+		StorageTargetID:   backupPlan.StorageTargetID,
+		Compression:       *backupPlan.Compression,
+		Encryption:        *backupPlan.Encryption,
+		CreatedAt:         backupPlan.CreatedAt,
+		UpdatedAt:         backupPlan.UpdatedAt,
+		WeekDays:          weekDays,
+		Sources:           backupPlanSourcesResponse(backupPlan.Sources),
 	}
 
-	response.JSON(w, http.StatusOK, "Plano de backup encontrado", res, nil, nil)
+	response.JSON(w, http.StatusOK, translate(r, "backup_plan.found"), res, nil, nil)
 }
 
 func (bph *BackupPlanHandler) ListBackupPlans(w http.ResponseWriter, r *http.Request) {
@@ -111,25 +123,25 @@ func (bph *BackupPlanHandler) ListBackupPlans(w http.ResponseWriter, r *http.Req
 	limitStr := r.URL.Query().Get("limit")
 
 	if pageStr == "" || limitStr == "" {
-		response.JSON(w, http.StatusBadRequest, "Page e limit são obrigatórios", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidPagination)
 		return
 	}
 
 	page, err := strconv.Atoi(pageStr)
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "Page inválido", nil, err.Error(), nil)
+		response.Error(w, r, domain.ErrInvalidPagination)
 		return
 	}
 
 	limit, err := strconv.Atoi(limitStr)
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "Limit inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidPagination)
 		return
 	}
 
 	backupPlans, err := bph.svc.ListBackupPlans(r.Context(), page, limit)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
@@ -142,6 +154,7 @@ func (bph *BackupPlanHandler) ListBackupPlans(w http.ResponseWriter, r *http.Req
 				ID:           wd.ID,
 				Day:          wd.Day,
 				TimeDay:      wd.TimeDay,
+				BackupType:   string(wd.BackupType),
 				CreatedAt:    wd.CreatedAt,
 				UpdatedAt:    wd.UpdatedAt,
 				BackupPlanID: wd.BackupPlanID,
@@ -149,70 +162,130 @@ func (bph *BackupPlanHandler) ListBackupPlans(w http.ResponseWriter, r *http.Req
 		}
 
 		list = append(list, dto.BackupPlanResponse{
-			ID:              backupPlan.ID,
-			Name:            backupPlan.Name,
-			BackupSizeBytes: backupPlan.BackupSizeBytes,
-			DeviceID:        backupPlan.DeviceID,
-			CreatedAt:       backupPlan.CreatedAt,
-			UpdatedAt:       backupPlan.UpdatedAt,
-			WeekDays:        weekDays,
+			ID:                backupPlan.ID,
+			Name:              backupPlan.Name,
+			BackupSizeBytes:   backupPlan.BackupSizeBytes,
+			DeviceID:          backupPlan.DeviceID,
+			RetentionPolicyID: backupPlan.RetentionPolicyID,
+			StorageTargetID:   backupPlan.StorageTargetID,
+			Compression:       *backupPlan.Compression,
+			Encryption:        *backupPlan.Encryption,
+			CreatedAt:         backupPlan.CreatedAt,
+			UpdatedAt:         backupPlan.UpdatedAt,
+			WeekDays:          weekDays,
+			Sources:           backupPlanSourcesResponse(backupPlan.Sources),
 		})
 	}
 
-	response.JSON(w, http.StatusOK, "Lista de planos de backup", list, nil, nil)
+	response.JSON(w, http.StatusOK, translate(r, "backup_plan.list"), list, nil, nil)
 }
 
 func (bph *BackupPlanHandler) UpdateBackupPlan(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "UUID inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidUUID)
 		return
 	}
 
-	var req dto.BackupPlanRequest
+	var req dto.UpdateBackupPlanRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.JSON(w, http.StatusBadRequest, "JSON inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidJSON)
 		return
 	}
 	defer r.Body.Close()
 
+	if err := bph.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
 	backupPlan := &domain.BackupPlan{
-		ID:              id,
-		Name:            req.Name,
-		BackupSizeBytes: req.BackupSizeBytes,
-		DeviceID:        req.DeviceID,
+		ID:                id,
+		Name:              req.Name,
+		BackupSizeBytes:   req.BackupSizeBytes,
+		DeviceID:          req.DeviceID,
+		RetentionPolicyID: req.RetentionPolicyID.Value,
+		StorageTargetID:   req.StorageTargetID.Value,
+		Compression:       req.Compression,
+		Encryption:        req.Encryption,
+		Sources:           backupPlanSources(req.Sources),
 	}
 
 	backupPlan.WeekDays = make([]domain.BackupPlanWeekDay, len(req.WeekDays))
 	for i, wdReq := range req.WeekDays {
 		backupPlan.WeekDays[i] = domain.BackupPlanWeekDay{
-			Day:          wdReq.Day,
+			Day:          normalizeWeekDay(wdReq.Day),
 			TimeDay:      wdReq.TimeDay,
+			BackupType:   domain.BackupType(wdReq.BackupType),
 			BackupPlanID: backupPlan.ID,
 		}
 	}
 
-	err = bph.svc.UpdateBackupPlan(r.Context(), backupPlan)
+	detach := domain.BackupPlanDetach{
+		RetentionPolicy: req.RetentionPolicyID.Set && req.RetentionPolicyID.Value == nil,
+		StorageTarget:   req.StorageTargetID.Set && req.StorageTargetID.Value == nil,
+	}
+
+	err = bph.svc.UpdateBackupPlan(r.Context(), backupPlan, detach, req.Force)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
-	response.JSON(w, http.StatusNoContent, "Plano de backup atualizado", nil, nil, nil)
+	response.JSON(w, http.StatusNoContent, translate(r, "backup_plan.updated"), nil, nil, nil)
 }
 
 func (bph *BackupPlanHandler) DeleteBackupPlan(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "UUID inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidUUID)
 		return
 	}
 
 	err = bph.svc.DeleteBackupPlan(r.Context(), id)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
-	response.JSON(w, http.StatusNoContent, "Plano de backup deletado com sucesso", nil, nil, nil)
+	response.JSON(w, http.StatusNoContent, translate(r, "backup_plan.deleted"), nil, nil, nil)
+}
+
+// normalizeWeekDay mantém o dia vazio, que na alteração herda o atual; dias
+// desconhecidos seguem como vieram para a validação do domínio recusar.
+func normalizeWeekDay(day string) string {
+	if day == "" {
+		return ""
+	}
+
+	normalized, _ := domain.NormalizeWeekDay(day)
+	return normalized
+}
+
+func backupPlanSources(req []dto.BackupPlanSourceRequest) []domain.BackupPlanSource {
+	sources := make([]domain.BackupPlanSource, len(req))
+	for i, source := range req {
+		sources[i] = domain.BackupPlanSource{
+			ID:       uuid.New(),
+			Kind:     domain.BackupSourceKind(source.Kind),
+			Location: source.Location,
+			Include:  source.Include,
+			Exclude:  source.Exclude,
+		}
+	}
+	return sources
+}
+
+func backupPlanSourcesResponse(sources []domain.BackupPlanSource) []dto.BackupPlanSourceResponse {
+	res := make([]dto.BackupPlanSourceResponse, len(sources))
+	for i, source := range sources {
+		res[i] = dto.BackupPlanSourceResponse{
+			ID:       source.ID,
+			Kind:     string(source.Kind),
+			Location: source.Location,
+			Include:  source.Include,
+			Exclude:  source.Exclude,
+		}
+	}
+	return res
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
 	"github.com/go-chi/chi/v5"
@@ -31,14 +32,13 @@ func NewCustomerHandler(svc port.CustomerService) *CustomerHandler {
 func (ch *CustomerHandler) CreateCustomer(w http.ResponseWriter, r *http.Request) {
 	var req dto.CustomerRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.JSON(w, http.StatusBadRequest, "JSON inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidJSON)
 		return
 	}
 	defer r.Body.Close()
 
 	if err := ch.validator.Struct(req); err != nil {
-		errorsMap := utils.ValidationErrorsToMap(err)
-		response.JSON(w, http.StatusBadRequest, "Dados de entrada inválidos", nil, domain.ErrBadRequest.Error(), errorsMap)
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
 		return
 	}
 
@@ -49,23 +49,23 @@ func (ch *CustomerHandler) CreateCustomer(w http.ResponseWriter, r *http.Request
 
 	err := ch.svc.CreateCustomer(r.Context(), &customer)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
-	response.JSON(w, http.StatusCreated, "Cliente cadastrado com sucesso", nil, nil, nil)
+	response.JSON(w, http.StatusCreated, translate(r, "customer.created"), nil, nil, nil)
 }
 
 func (ch *CustomerHandler) GetCustomer(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "UUID inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidUUID)
 		return
 	}
 
 	customer, err := ch.svc.GetCustomer(r.Context(), id)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
@@ -76,7 +76,7 @@ func (ch *CustomerHandler) GetCustomer(w http.ResponseWriter, r *http.Request) {
 		UpdatedAt: customer.UpdatedAt,
 	}
 
-	response.JSON(w, http.StatusOK, "Cliente encontrado", res, nil, nil)
+	response.JSON(w, http.StatusOK, translate(r, "customer.found"), res, nil, nil)
 }
 
 func (ch *CustomerHandler) ListCustomers(w http.ResponseWriter, r *http.Request) {
@@ -84,25 +84,25 @@ func (ch *CustomerHandler) ListCustomers(w http.ResponseWriter, r *http.Request)
 	limitStr := r.URL.Query().Get("limit")
 
 	if pageStr == "" || limitStr == "" {
-		response.JSON(w, http.StatusBadRequest, "Page e limit são obrigatórios", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidPagination)
 		return
 	}
 
 	page, err := strconv.Atoi(pageStr)
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "Page inválido", nil, err.Error(), nil)
+		response.Error(w, r, domain.ErrInvalidPagination)
 		return
 	}
 
 	limit, err := strconv.Atoi(limitStr)
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "Limit inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidPagination)
 		return
 	}
 
 	customers, err := ch.svc.ListCustomers(r.Context(), page, limit)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
@@ -116,26 +116,25 @@ func (ch *CustomerHandler) ListCustomers(w http.ResponseWriter, r *http.Request)
 		})
 	}
 
-	response.JSON(w, http.StatusOK, "Lista de clientes", list, nil, nil)
+	response.JSON(w, http.StatusOK, translate(r, "customer.list"), list, nil, nil)
 }
 
 func (ch *CustomerHandler) UpdateCustomer(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "UUID inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidUUID)
 		return
 	}
 
 	var req dto.CustomerRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.JSON(w, http.StatusBadRequest, "JSON inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidJSON)
 		return
 	}
 	defer r.Body.Close()
 
 	if err := ch.validator.Struct(req); err != nil {
-		errorsMap := utils.ValidationErrorsToMap(err)
-		response.JSON(w, http.StatusBadRequest, "Dados de entrada inválidos", nil, domain.ErrBadRequest.Error(), errorsMap)
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
 		return
 	}
 
@@ -146,25 +145,25 @@ func (ch *CustomerHandler) UpdateCustomer(w http.ResponseWriter, r *http.Request
 
 	err = ch.svc.UpdateCustomer(r.Context(), &customer)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
-	response.JSON(w, http.StatusNoContent, "Cliente alterado com sucesso", nil, nil, nil)
+	response.JSON(w, http.StatusNoContent, translate(r, "customer.updated"), nil, nil, nil)
 }
 
 func (ch *CustomerHandler) DeleteCustomer(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "UUID inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidUUID)
 		return
 	}
 
 	err = ch.svc.DeleteCustomer(r.Context(), id)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
-	response.JSON(w, http.StatusOK, "Cliente deletado com sucesso", nil, nil, nil)
+	response.JSON(w, http.StatusOK, translate(r, "customer.deleted"), nil, nil, nil)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
 	"github.com/go-chi/chi/v5"
@@ -31,20 +32,19 @@ func NewDeviceHandler(svc port.DeviceService) *DeviceHandler {
 func (dh *DeviceHandler) CreateDevice(w http.ResponseWriter, r *http.Request) {
 	var req dto.DeviceRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.JSON(w, http.StatusBadRequest, "JSON inválido", nil, err.Error(), nil)
+		response.Error(w, r, domain.ErrInvalidJSON)
 		return
 	}
 	defer r.Body.Close()
 
 	customerId, err := uuid.Parse(req.CustomerID)
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "UUID inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidUUID)
 		return
 	}
 
 	if err := dh.validator.Struct(req); err != nil {
-		errorsMap := utils.ValidationErrorsToMap(err)
-		response.JSON(w, http.StatusBadRequest, "Dados de entrada inválidos", nil, domain.ErrBadRequest.Error(), errorsMap)
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
 		return
 	}
 
@@ -56,23 +56,23 @@ func (dh *DeviceHandler) CreateDevice(w http.ResponseWriter, r *http.Request) {
 
 	err = dh.svc.CreateDevice(r.Context(), &device)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
-	response.JSON(w, http.StatusCreated, "Dispositivo vinculado com sucesso", nil, nil, nil)
+	response.JSON(w, http.StatusCreated, translate(r, "device.created"), nil, nil, nil)
 }
 
 func (dh *DeviceHandler) GetDevice(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "UUID inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidUUID)
 		return
 	}
 
 	device, err := dh.svc.GetDevice(r.Context(), id)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
@@ -84,7 +84,7 @@ func (dh *DeviceHandler) GetDevice(w http.ResponseWriter, r *http.Request) {
 		UpdatedAt:  device.UpdatedAt,
 	}
 
-	response.JSON(w, http.StatusOK, "Dispositivo encontrado", res, nil, nil)
+	response.JSON(w, http.StatusOK, translate(r, "device.found"), res, nil, nil)
 }
 
 func (dh *DeviceHandler) ListDevices(w http.ResponseWriter, r *http.Request) {
@@ -92,25 +92,25 @@ func (dh *DeviceHandler) ListDevices(w http.ResponseWriter, r *http.Request) {
 	limitStr := r.URL.Query().Get("limit")
 
 	if pageStr == "" || limitStr == "" {
-		response.JSON(w, http.StatusBadRequest, "Page e limit são obrigatórios", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidPagination)
 		return
 	}
 
 	page, err := strconv.Atoi(pageStr)
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "Page inválido", nil, err.Error(), nil)
+		response.Error(w, r, domain.ErrInvalidPagination)
 		return
 	}
 
 	limit, err := strconv.Atoi(limitStr)
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "Limit inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidPagination)
 		return
 	}
 
 	devices, err := dh.svc.ListDevices(r.Context(), page, limit)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
@@ -125,32 +125,31 @@ func (dh *DeviceHandler) ListDevices(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
-	response.JSON(w, http.StatusOK, "Lista de dispositivos", list, nil, nil)
+	response.JSON(w, http.StatusOK, translate(r, "device.list"), list, nil, nil)
 }
 
 func (dh *DeviceHandler) UpdateDevice(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "UUID inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidUUID)
 		return
 	}
 
 	var req dto.DeviceRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.JSON(w, http.StatusBadRequest, "JSON inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidJSON)
 		return
 	}
 	defer r.Body.Close()
 
 	customerId, err := uuid.Parse(req.CustomerID)
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "UUID inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidUUID)
 		return
 	}
 
 	if err := dh.validator.Struct(req); err != nil {
-		errorsMap := utils.ValidationErrorsToMap(err)
-		response.JSON(w, http.StatusBadRequest, "Dados de entrada inválidos", nil, domain.ErrBadRequest.Error(), errorsMap)
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
 		return
 	}
 
@@ -162,25 +161,25 @@ func (dh *DeviceHandler) UpdateDevice(w http.ResponseWriter, r *http.Request) {
 
 	err = dh.svc.UpdateDevice(r.Context(), &device)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
-	response.JSON(w, http.StatusOK, "Dispositivo atualizado", nil, nil, nil)
+	response.JSON(w, http.StatusOK, translate(r, "device.updated"), nil, nil, nil)
 }
 
 func (dh *DeviceHandler) DeleteDevice(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "UUID inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidUUID)
 		return
 	}
 
 	err = dh.svc.DeleteDevice(r.Context(), id)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
-	response.JSON(w, http.StatusOK, "Dispositivo deletado com sucesso", nil, nil, nil)
+	response.JSON(w, http.StatusOK, translate(r, "device.deleted"), nil, nil, nil)
 }
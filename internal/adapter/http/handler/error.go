@@ -1,21 +1,23 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
 )
 
-func handleServiceError(w http.ResponseWriter, err error) {
-	switch err {
-	case domain.ErrBadRequest:
-		response.JSON(w, http.StatusBadRequest, "Requisição inválida", nil, err.Error(), nil)
-	case domain.ErrDataNotFound:
-		response.JSON(w, http.StatusNotFound, "Recurso não encontrado", nil, err.Error(), nil)
-	case domain.ErrConflictingData:
-		response.JSON(w, http.StatusConflict, "Conflito de dados", nil, err.Error(), nil)
-	default:
-		response.JSON(w, http.StatusInternalServerError, "Erro interno do servidor", nil, err.Error(), nil)
+func handleServiceError(w http.ResponseWriter, r *http.Request, err error) {
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		domainErr = domain.ErrInternal
 	}
+
+	if domainErr.Status >= http.StatusInternalServerError {
+		utils.Logger(r.Context()).ErrorContext(r.Context(), "Erro ao processar a requisição", "error", err)
+	}
+
+	response.Error(w, r, domainErr)
 }
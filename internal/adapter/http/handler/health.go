@@ -1,17 +1,67 @@
 package handler
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
 )
 
-type HealthCheckHandler struct{}
+const healthCheckTimeout = 2 * time.Second
 
-func NewHealthCheckHandler() *HealthCheckHandler {
-	return &HealthCheckHandler{}
+type HealthCheckHandler struct {
+	checks []port.HealthChecker
+}
+
+func NewHealthCheckHandler(checks ...port.HealthChecker) *HealthCheckHandler {
+	return &HealthCheckHandler{
+		checks,
+	}
+}
+
+type healthCheckResult struct {
+	Status     string `json:"status"`
+	DurationMs int64  `json:"duration_ms"`
 }
 
 func (h *HealthCheckHandler) Health(w http.ResponseWriter, r *http.Request) {
-	response.JSON(w, http.StatusOK, "ok", "api está saudável", nil, nil)
+	response.JSON(w, http.StatusOK, "ok", translate(r, "health.ok"), nil, nil)
+}
+
+func (h *HealthCheckHandler) Live(w http.ResponseWriter, r *http.Request) {
+	response.JSON(w, http.StatusOK, "ok", map[string]string{"status": "ok"}, nil, nil)
+}
+
+func (h *HealthCheckHandler) Ready(w http.ResponseWriter, r *http.Request) {
+	results := make(map[string]healthCheckResult, len(h.checks))
+	ready := true
+
+	for _, check := range h.checks {
+		ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+		start := time.Now()
+		err := check.Check(ctx)
+		cancel()
+
+		result := healthCheckResult{
+			Status:     "ok",
+			DurationMs: time.Since(start).Milliseconds(),
+		}
+		// O endpoint é público: o detalhe do erro vai só para o log.
+		if err != nil {
+			ready = false
+			result.Status = "fail"
+			utils.Logger(r.Context()).ErrorContext(r.Context(), "Verificação de prontidão falhou", "check", check.Name(), "error", err.Error())
+		}
+		results[check.Name()] = result
+	}
+
+	if !ready {
+		response.JSON(w, http.StatusServiceUnavailable, translate(r, "health.not_ready"), results, nil, nil)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, "ok", results, nil, nil)
 }
@@ -0,0 +1,11 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+)
+
+func translate(r *http.Request, key string) string {
+	return i18n.T(i18n.FromContext(r.Context()), key)
+}
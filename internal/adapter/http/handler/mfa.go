@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/middlewares"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/go-playground/validator/v10"
+)
+
+type MFAHandler struct {
+	validator *validator.Validate
+	svc       port.MFAService
+}
+
+func NewMFAHandler(svc port.MFAService) *MFAHandler {
+	validator := validator.New(validator.WithRequiredStructEnabled())
+	return &MFAHandler{
+		validator,
+		svc,
+	}
+}
+
+func (mh *MFAHandler) EnrollTOTP(w http.ResponseWriter, r *http.Request) {
+	payload, ok := middlewares.Payload(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+		return
+	}
+
+	enrollment, err := mh.svc.EnrollTOTP(r.Context(), payload.UserID)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	res := dto.TOTPEnrollmentResponse{
+		Secret:        enrollment.Secret,
+		URI:           enrollment.URI,
+		RecoveryCodes: enrollment.RecoveryCodes,
+	}
+
+	response.JSON(w, http.StatusCreated, translate(r, "mfa.enrolled"), res, nil, nil)
+}
+
+func (mh *MFAHandler) ConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+	payload, ok := middlewares.Payload(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+		return
+	}
+
+	var req dto.MFACodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := mh.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	err := mh.svc.ConfirmTOTP(r.Context(), payload.UserID, req.Code)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "mfa.enabled"), nil, nil, nil)
+}
+
+func (mh *MFAHandler) DisableTOTP(w http.ResponseWriter, r *http.Request) {
+	payload, ok := middlewares.Payload(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+		return
+	}
+
+	var req dto.MFACodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := mh.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	err := mh.svc.DisableTOTP(r.Context(), payload.UserID, req.Code)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "mfa.disabled"), nil, nil, nil)
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/middlewares"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+)
+
+// oidcStateCookie prende o state ao navegador que iniciou o login, para que
+// um callback forjado em outra sessão seja recusado.
+const oidcStateCookie = "oidc_state"
+
+type OIDCHandler struct {
+	svc port.OIDCService
+}
+
+func NewOIDCHandler(svc port.OIDCService) *OIDCHandler {
+	return &OIDCHandler{
+		svc,
+	}
+}
+
+func (oh *OIDCHandler) Login(w http.ResponseWriter, r *http.Request) {
+	login, err := oh.svc.Login(r.Context())
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	setOIDCStateCookie(w, r, login)
+	http.Redirect(w, r, login.AuthURL, http.StatusFound)
+}
+
+// Link devolve a URL do provedor em vez de redirecionar, já que a chamada
+// precisa do token da API; o cliente navega até ela em seguida.
+func (oh *OIDCHandler) Link(w http.ResponseWriter, r *http.Request) {
+	payload, ok := middlewares.Payload(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+		return
+	}
+
+	login, err := oh.svc.Link(r.Context(), payload.UserID)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	setOIDCStateCookie(w, r, login)
+	response.JSON(w, http.StatusOK, translate(r, "auth.oidc_link"), dto.OIDCLinkResponse{
+		AuthorizationURL: login.AuthURL,
+	}, nil, nil)
+}
+
+func (oh *OIDCHandler) Callback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	// O cookie vale para uma única tentativa, aceita ou não.
+	cookie, cookieErr := r.Cookie(oidcStateCookie)
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Path:     "/",
+		MaxAge:   -1,
+		Secure:   isHTTPS(r),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	// O provedor devolve error quando o usuário nega o consentimento ou o
+	// login falha do lado dele.
+	if providerErr := query.Get("error"); providerErr != "" {
+		utils.Logger(r.Context()).WarnContext(r.Context(), "Login OIDC recusado pelo provedor", "error", providerErr, "description", query.Get("error_description"))
+		response.Error(w, r, domain.ErrUnauthorized)
+		return
+	}
+
+	state := query.Get("state")
+	code := query.Get("code")
+	if state == "" || code == "" {
+		response.Error(w, r, domain.ErrInvalidOIDCState)
+		return
+	}
+
+	if cookieErr != nil || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(state)) != 1 {
+		response.Error(w, r, domain.ErrInvalidOIDCState)
+		return
+	}
+
+	result, err := oh.svc.Callback(r.Context(), state, code)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "auth.login"), result.AccessToken, nil, nil)
+}
+
+// setOIDCStateCookie usa Lax, e não Strict: o callback chega por um
+// redirecionamento do provedor, que é uma navegação vinda de outro site.
+func setOIDCStateCookie(w http.ResponseWriter, r *http.Request, login *domain.OIDCLogin) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    login.State,
+		Path:     "/",
+		Expires:  login.ExpiresAt,
+		Secure:   isHTTPS(r),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// isHTTPS considera também o X-Forwarded-Proto: marcar o cookie como Secure a
+// mais só impede que ele trafegue sem TLS.
+func isHTTPS(r *http.Request) bool {
+	return r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https"
+}
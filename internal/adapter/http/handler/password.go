@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/middlewares"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type PasswordHandler struct {
+	validator *validator.Validate
+	svc       port.PasswordService
+}
+
+func NewPasswordHandler(svc port.PasswordService) *PasswordHandler {
+	validator := validator.New(validator.WithRequiredStructEnabled())
+	return &PasswordHandler{
+		validator,
+		svc,
+	}
+}
+
+func (ph *PasswordHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	payload, ok := middlewares.Payload(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+		return
+	}
+
+	var req dto.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := ph.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	err := ph.svc.ChangePassword(r.Context(), payload.UserID, payload.ID, req.CurrentPassword, req.NewPassword)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "password.changed"), nil, nil, nil)
+}
+
+func (ph *PasswordHandler) ForcePasswordChange(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	err = ph.svc.ForcePasswordChange(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "password.change_forced"), nil, nil, nil)
+}
@@ -0,0 +1,285 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/middlewares"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type RestorePointHandler struct {
+	validator *validator.Validate
+	svc       port.RestorePointService
+}
+
+func NewRestorePointHandler(svc port.RestorePointService) *RestorePointHandler {
+	validator := validator.New(validator.WithRequiredStructEnabled())
+	return &RestorePointHandler{
+		validator,
+		svc,
+	}
+}
+
+func (rph *RestorePointHandler) ReportRestorePoints(w http.ResponseWriter, r *http.Request) {
+	device, ok := middlewares.Device(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrUnauthorized)
+		return
+	}
+
+	var req dto.ReportRestorePointsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := rph.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	points := make([]domain.RestorePoint, len(req.RestorePoints))
+	for i, point := range req.RestorePoints {
+		points[i] = domain.RestorePoint{
+			ID:        point.ID,
+			Type:      domain.BackupType(point.Type),
+			SizeBytes: point.SizeBytes,
+			Checksum:  point.Checksum,
+			Location:  point.Location,
+			CreatedAt: point.CreatedAt,
+		}
+	}
+
+	expired, err := rph.svc.ReportRestorePoints(r.Context(), device.ID, req.BackupPlanID, points)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	res := dto.RestorePointReportResponse{
+		Expired: restorePointsResponse(expired),
+	}
+
+	response.JSON(w, http.StatusCreated, translate(r, "restore_point.reported"), res, nil, nil)
+}
+
+func (rph *RestorePointHandler) DeleteAgentRestorePoint(w http.ResponseWriter, r *http.Request) {
+	device, ok := middlewares.Device(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrUnauthorized)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	err = rph.svc.DeleteAgentRestorePoint(r.Context(), device.ID, id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, translate(r, "restore_point.deleted"), nil, nil, nil)
+}
+
+func (rph *RestorePointHandler) ListRestorePoints(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	pageStr := r.URL.Query().Get("page")
+	limitStr := r.URL.Query().Get("limit")
+
+	if pageStr == "" || limitStr == "" {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	page, err := strconv.Atoi(pageStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	points, err := rph.svc.ListRestorePoints(r.Context(), id, page, limit)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "restore_point.list"), restorePointsResponse(points), nil, nil)
+}
+
+// ListDeviceRestorePoints devolve o ponto mais recente de cada plano do
+// dispositivo criado até at (RFC 3339); sem at, até agora.
+func (rph *RestorePointHandler) ListDeviceRestorePoints(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	at := time.Now()
+	if atStr := r.URL.Query().Get("at"); atStr != "" {
+		at, err = time.Parse(time.RFC3339, atStr)
+		if err != nil {
+			response.Error(w, r, domain.ErrValidation.WithDetails(map[string]string{
+				"at": i18n.T(i18n.FromContext(r.Context()), "validation.datetime", "at", time.RFC3339),
+			}))
+			return
+		}
+	}
+
+	points, err := rph.svc.ListDeviceRestorePoints(r.Context(), id, at)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "restore_point.list"), restorePointsResponse(points), nil, nil)
+}
+
+func (rph *RestorePointHandler) GetSizeHistory(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	history, err := rph.svc.GetSizeHistory(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	list := make([]dto.SizeHistoryResponse, 0, len(history))
+	for _, entry := range history {
+		res := dto.SizeHistoryResponse{
+			RestorePointID: entry.RestorePoint.ID,
+			Type:           string(entry.RestorePoint.Type),
+			SizeBytes:      entry.RestorePoint.SizeBytes,
+			CreatedAt:      entry.RestorePoint.CreatedAt,
+		}
+
+		if entry.Baseline != nil {
+			res.Baseline = &dto.SizeBaselineResponse{
+				Samples: entry.Baseline.Samples,
+				Median:  entry.Baseline.Median,
+				MAD:     entry.Baseline.MAD,
+				Lower:   entry.Baseline.Lower,
+				Upper:   entry.Baseline.Upper,
+			}
+		}
+
+		if entry.Alert != nil {
+			res.Anomaly = &dto.SizeAnomalyResponse{
+				Kind:     string(entry.Alert.Kind),
+				Severity: string(entry.Alert.Severity),
+			}
+		}
+
+		list = append(list, res)
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "restore_point.size_history"), list, nil, nil)
+}
+
+// ListSizeAlerts lista os alertas de tamanho de todos os planos, os mais
+// recentes primeiro; severity filtra por warning ou critical.
+func (rph *RestorePointHandler) ListSizeAlerts(w http.ResponseWriter, r *http.Request) {
+	pageStr := r.URL.Query().Get("page")
+	limitStr := r.URL.Query().Get("limit")
+
+	if pageStr == "" || limitStr == "" {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	page, err := strconv.Atoi(pageStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	severity := domain.SizeAlertSeverity(r.URL.Query().Get("severity"))
+	switch severity {
+	case "", domain.SizeAlertWarning, domain.SizeAlertCritical:
+	default:
+		response.Error(w, r, domain.ErrValidation.WithDetails(map[string]string{
+			"severity": i18n.T(i18n.FromContext(r.Context()), "validation.oneof", "severity", "warning critical"),
+		}))
+		return
+	}
+
+	alerts, err := rph.svc.ListSizeAlerts(r.Context(), severity, page, limit)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	list := make([]dto.SizeAlertResponse, 0, len(alerts))
+	for _, alert := range alerts {
+		list = append(list, dto.SizeAlertResponse{
+			ID:             alert.ID,
+			BackupPlanID:   alert.BackupPlanID,
+			RestorePointID: alert.RestorePointID,
+			Type:           string(alert.Type),
+			Kind:           string(alert.Kind),
+			Severity:       string(alert.Severity),
+			SizeBytes:      alert.SizeBytes,
+			BaselineMedian: alert.BaselineMedian,
+			BaselineMAD:    alert.BaselineMAD,
+			PointCreatedAt: alert.PointCreatedAt,
+			CreatedAt:      alert.CreatedAt,
+		})
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "restore_point.size_alerts"), list, nil, nil)
+}
+
+func restorePointsResponse(points []domain.RestorePoint) []dto.RestorePointResponse {
+	list := make([]dto.RestorePointResponse, 0, len(points))
+	for _, point := range points {
+		list = append(list, dto.RestorePointResponse{
+			ID:           point.ID,
+			BackupPlanID: point.BackupPlanID,
+			Type:         string(point.Type),
+			SizeBytes:    point.SizeBytes,
+			Checksum:     point.Checksum,
+			Location:     point.Location,
+			CreatedAt:    point.CreatedAt,
+			ExpiresAt:    point.ExpiresAt,
+			ReportedAt:   point.ReportedAt,
+		})
+	}
+	return list
+}
@@ -0,0 +1,295 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/middlewares"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+var restoreRequestStatuses = []string{
+	string(domain.RestoreRequested),
+	string(domain.RestoreApproved),
+	string(domain.RestoreRejected),
+	string(domain.RestoreInProgress),
+	string(domain.RestoreCompleted),
+	string(domain.RestoreFailed),
+}
+
+type RestoreRequestHandler struct {
+	validator *validator.Validate
+	svc       port.RestoreRequestService
+}
+
+func NewRestoreRequestHandler(svc port.RestoreRequestService) *RestoreRequestHandler {
+	validator := validator.New(validator.WithRequiredStructEnabled())
+	return &RestoreRequestHandler{
+		validator,
+		svc,
+	}
+}
+
+func (rrh *RestoreRequestHandler) CreateRestoreRequest(w http.ResponseWriter, r *http.Request) {
+	payload, ok := middlewares.Payload(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+		return
+	}
+
+	var req dto.CreateRestoreRequestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := rrh.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	request := &domain.RestoreRequest{
+		ID:             uuid.New(),
+		BackupPlanID:   req.BackupPlanID,
+		RestorePointID: req.RestorePointID,
+		TargetPath:     req.TargetPath,
+		Reason:         req.Reason,
+		RequestedBy:    &payload.UserID,
+	}
+	if req.TargetDeviceID != nil {
+		request.TargetDeviceID = *req.TargetDeviceID
+	}
+
+	err := rrh.svc.CreateRestoreRequest(r.Context(), request)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, translate(r, "restore_request.created"), restoreRequestResponse(request), nil, nil)
+}
+
+func (rrh *RestoreRequestHandler) GetRestoreRequest(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	request, err := rrh.svc.GetRestoreRequest(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "restore_request.found"), restoreRequestResponse(request), nil, nil)
+}
+
+// ListRestoreRequests aceita o filtro opcional status.
+func (rrh *RestoreRequestHandler) ListRestoreRequests(w http.ResponseWriter, r *http.Request) {
+	pageStr := r.URL.Query().Get("page")
+	limitStr := r.URL.Query().Get("limit")
+
+	if pageStr == "" || limitStr == "" {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	page, err := strconv.Atoi(pageStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	status := r.URL.Query().Get("status")
+	if status != "" && !slices.Contains(restoreRequestStatuses, status) {
+		response.Error(w, r, domain.ErrValidation.WithDetails(map[string]string{
+			"status": i18n.T(i18n.FromContext(r.Context()), "validation.oneof", "status", strings.Join(restoreRequestStatuses, " ")),
+		}))
+		return
+	}
+
+	requests, err := rrh.svc.ListRestoreRequests(r.Context(), domain.RestoreRequestStatus(status), page, limit)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "restore_request.list"), restoreRequestsResponse(requests), nil, nil)
+}
+
+func (rrh *RestoreRequestHandler) ApproveRestoreRequest(w http.ResponseWriter, r *http.Request) {
+	rrh.review(w, r, true)
+}
+
+func (rrh *RestoreRequestHandler) RejectRestoreRequest(w http.ResponseWriter, r *http.Request) {
+	rrh.review(w, r, false)
+}
+
+func (rrh *RestoreRequestHandler) review(w http.ResponseWriter, r *http.Request, approve bool) {
+	payload, ok := middlewares.Payload(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	var req dto.ReviewRestoreRequestRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			response.Error(w, r, domain.ErrInvalidJSON)
+			return
+		}
+		defer r.Body.Close()
+	}
+
+	if err := rrh.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	if !approve && strings.TrimSpace(req.Note) == "" {
+		response.Error(w, r, domain.ErrValidation.WithDetails(map[string]string{
+			"note": i18n.T(i18n.FromContext(r.Context()), "validation.required", "note"),
+		}))
+		return
+	}
+
+	request, err := rrh.svc.ReviewRestoreRequest(r.Context(), payload.UserID, id, approve, req.Note)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	message := "restore_request.rejected"
+	if approve {
+		message = "restore_request.approved"
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, message), restoreRequestResponse(request), nil, nil)
+}
+
+func (rrh *RestoreRequestHandler) ListAgentRestores(w http.ResponseWriter, r *http.Request) {
+	device, ok := middlewares.Device(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrUnauthorized)
+		return
+	}
+
+	requests, err := rrh.svc.ListAgentRestores(r.Context(), device.ID)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "restore_request.list"), restoreRequestsResponse(requests), nil, nil)
+}
+
+func (rrh *RestoreRequestHandler) StartRestore(w http.ResponseWriter, r *http.Request) {
+	device, ok := middlewares.Device(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrUnauthorized)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	request, err := rrh.svc.StartRestore(r.Context(), device.ID, id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "restore_request.started"), restoreRequestResponse(request), nil, nil)
+}
+
+func (rrh *RestoreRequestHandler) CompleteRestore(w http.ResponseWriter, r *http.Request) {
+	device, ok := middlewares.Device(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrUnauthorized)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	var req dto.RestoreResultRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := rrh.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	success := domain.RestoreRequestStatus(req.Status) == domain.RestoreCompleted
+	request, err := rrh.svc.CompleteRestore(r.Context(), device.ID, id, success, req.Result)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "restore_request.completed"), restoreRequestResponse(request), nil, nil)
+}
+
+func restoreRequestResponse(request *domain.RestoreRequest) dto.RestoreRequestResponse {
+	return dto.RestoreRequestResponse{
+		ID:             request.ID,
+		BackupPlanID:   request.BackupPlanID,
+		RestorePointID: request.RestorePointID,
+		TargetDeviceID: request.TargetDeviceID,
+		TargetPath:     request.TargetPath,
+		Reason:         request.Reason,
+		Status:         string(request.Status),
+		RequestedBy:    request.RequestedBy,
+		ReviewedBy:     request.ReviewedBy,
+		ReviewNote:     request.ReviewNote,
+		ReviewedAt:     request.ReviewedAt,
+		StartedAt:      request.StartedAt,
+		CompletedAt:    request.CompletedAt,
+		Result:         request.Result,
+		CreatedAt:      request.CreatedAt,
+		UpdatedAt:      request.UpdatedAt,
+	}
+}
+
+func restoreRequestsResponse(requests []domain.RestoreRequest) []dto.RestoreRequestResponse {
+	list := make([]dto.RestoreRequestResponse, 0, len(requests))
+	for _, request := range requests {
+		list = append(list, restoreRequestResponse(&request))
+	}
+	return list
+}
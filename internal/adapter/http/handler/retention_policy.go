@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type RetentionPolicyHandler struct {
+	validator *validator.Validate
+	svc       port.RetentionPolicyService
+}
+
+func NewRetentionPolicyHandler(svc port.RetentionPolicyService) *RetentionPolicyHandler {
+	validator := validator.New(validator.WithRequiredStructEnabled())
+	return &RetentionPolicyHandler{
+		validator,
+		svc,
+	}
+}
+
+func (rph *RetentionPolicyHandler) CreateRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateRetentionPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := rph.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	policy := &domain.RetentionPolicy{
+		ID:          uuid.New(),
+		CustomerID:  req.CustomerID,
+		Name:        req.Name,
+		KeepLast:    req.KeepLast,
+		KeepDaily:   req.KeepDaily,
+		KeepWeekly:  req.KeepWeekly,
+		KeepMonthly: req.KeepMonthly,
+		KeepYearly:  req.KeepYearly,
+		MaxAgeDays:  req.MaxAgeDays,
+	}
+
+	err := rph.svc.CreateRetentionPolicy(r.Context(), policy)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, translate(r, "retention_policy.created"), retentionPolicyResponse(policy), nil, nil)
+}
+
+func (rph *RetentionPolicyHandler) GetRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	policy, err := rph.svc.GetRetentionPolicy(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "retention_policy.found"), retentionPolicyResponse(policy), nil, nil)
+}
+
+func (rph *RetentionPolicyHandler) ListRetentionPolicies(w http.ResponseWriter, r *http.Request) {
+	pageStr := r.URL.Query().Get("page")
+	limitStr := r.URL.Query().Get("limit")
+
+	if pageStr == "" || limitStr == "" {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	page, err := strconv.Atoi(pageStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	var customerID uuid.UUID
+	if customerIDStr := r.URL.Query().Get("customer_id"); customerIDStr != "" {
+		customerID, err = uuid.Parse(customerIDStr)
+		if err != nil {
+			response.Error(w, r, domain.ErrInvalidUUID)
+			return
+		}
+	}
+
+	policies, err := rph.svc.ListRetentionPolicies(r.Context(), customerID, page, limit)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	list := make([]dto.RetentionPolicyResponse, 0, len(policies))
+	for _, policy := range policies {
+		list = append(list, retentionPolicyResponse(&policy))
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "retention_policy.list"), list, nil, nil)
+}
+
+func (rph *RetentionPolicyHandler) UpdateRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	var req dto.UpdateRetentionPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := rph.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	policy := &domain.RetentionPolicy{
+		ID:          id,
+		Name:        req.Name,
+		KeepLast:    req.KeepLast,
+		KeepDaily:   req.KeepDaily,
+		KeepWeekly:  req.KeepWeekly,
+		KeepMonthly: req.KeepMonthly,
+		KeepYearly:  req.KeepYearly,
+		MaxAgeDays:  req.MaxAgeDays,
+	}
+
+	err = rph.svc.UpdateRetentionPolicy(r.Context(), policy)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, translate(r, "retention_policy.updated"), nil, nil, nil)
+}
+
+func (rph *RetentionPolicyHandler) DeleteRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	err = rph.svc.DeleteRetentionPolicy(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, translate(r, "retention_policy.deleted"), nil, nil, nil)
+}
+
+// EvaluateRetentionPolicy simula a política sobre os pontos de restauração
+// informados, sem descartar nada.
+func (rph *RetentionPolicyHandler) EvaluateRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	rph.evaluate(w, r, rph.svc.EvaluateRetentionPolicy)
+}
+
+// EvaluateBackupPlanRetention aplica a política do plano aos pontos de
+// restauração informados.
+func (rph *RetentionPolicyHandler) EvaluateBackupPlanRetention(w http.ResponseWriter, r *http.Request) {
+	rph.evaluate(w, r, rph.svc.EvaluateBackupPlanRetention)
+}
+
+type evaluateFunc func(ctx context.Context, id uuid.UUID, points []domain.RestorePoint, now time.Time) ([]domain.RetentionDecision, error)
+
+func (rph *RetentionPolicyHandler) evaluate(w http.ResponseWriter, r *http.Request, evaluate evaluateFunc) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	var req dto.RetentionEvaluateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := rph.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	points := make([]domain.RestorePoint, len(req.RestorePoints))
+	for i, point := range req.RestorePoints {
+		points[i] = domain.RestorePoint{
+			ID:        point.ID,
+			CreatedAt: point.CreatedAt,
+			Type:      utils.Coalesce(domain.BackupType(point.Type), domain.BackupTypeFull),
+		}
+	}
+
+	decisions, err := evaluate(r.Context(), id, points, time.Now())
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	res := make([]dto.RetentionDecisionResponse, 0, len(decisions))
+	for _, decision := range decisions {
+		res = append(res, dto.RetentionDecisionResponse{
+			ID:        decision.RestorePoint.ID,
+			CreatedAt: decision.RestorePoint.CreatedAt,
+			Keep:      decision.Keep,
+			Reasons:   decision.Reasons,
+		})
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "retention_policy.evaluated"), res, nil, nil)
+}
+
+func retentionPolicyResponse(policy *domain.RetentionPolicy) dto.RetentionPolicyResponse {
+	return dto.RetentionPolicyResponse{
+		ID:          policy.ID,
+		CustomerID:  policy.CustomerID,
+		Name:        policy.Name,
+		KeepLast:    policy.KeepLast,
+		KeepDaily:   policy.KeepDaily,
+		KeepWeekly:  policy.KeepWeekly,
+		KeepMonthly: policy.KeepMonthly,
+		KeepYearly:  policy.KeepYearly,
+		MaxAgeDays:  policy.MaxAgeDays,
+		CreatedAt:   policy.CreatedAt,
+		UpdatedAt:   policy.UpdatedAt,
+	}
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+)
+
+type SecretHandler struct {
+	svc port.SecretService
+}
+
+func NewSecretHandler(svc port.SecretService) *SecretHandler {
+	return &SecretHandler{
+		svc,
+	}
+}
+
+func (sh *SecretHandler) RotateMasterKey(w http.ResponseWriter, r *http.Request) {
+	rotation, err := sh.svc.RotateMasterKey(r.Context())
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	res := dto.SecretRotationResponse{
+		KeyID:   rotation.KeyID,
+		Rotated: rotation.Rotated,
+		Failed:  rotation.Failed,
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "secret.rotated"), res, nil, nil)
+}
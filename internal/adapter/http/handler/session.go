@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/middlewares"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type SessionHandler struct {
+	svc port.SessionService
+}
+
+func NewSessionHandler(svc port.SessionService) *SessionHandler {
+	return &SessionHandler{
+		svc,
+	}
+}
+
+func (sh *SessionHandler) ListMySessions(w http.ResponseWriter, r *http.Request) {
+	payload, ok := middlewares.Payload(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+		return
+	}
+
+	sh.list(w, r, payload, payload.UserID)
+}
+
+func (sh *SessionHandler) RevokeMySession(w http.ResponseWriter, r *http.Request) {
+	payload, ok := middlewares.Payload(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	err = sh.svc.RevokeUserSession(r.Context(), payload.UserID, id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, translate(r, "session.revoked"), nil, nil, nil)
+}
+
+func (sh *SessionHandler) ListUserSessions(w http.ResponseWriter, r *http.Request) {
+	payload, ok := middlewares.Payload(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+		return
+	}
+
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	sh.list(w, r, payload, userID)
+}
+
+func (sh *SessionHandler) RevokeUserSession(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "session_id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	err = sh.svc.RevokeUserSession(r.Context(), userID, id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, translate(r, "session.revoked"), nil, nil, nil)
+}
+
+func (sh *SessionHandler) RevokeUserSessions(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	err = sh.svc.RevokeUserSessions(r.Context(), userID)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "session.revoked_all"), nil, nil, nil)
+}
+
+func (sh *SessionHandler) list(w http.ResponseWriter, r *http.Request, payload *domain.TokenPayload, userID uuid.UUID) {
+	sessions, err := sh.svc.ListUserSessions(r.Context(), userID)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	list := make([]dto.SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		list = append(list, dto.SessionResponse{
+			ID:         session.ID,
+			IP:         session.IP,
+			UserAgent:  session.UserAgent,
+			MFA:        session.MFA,
+			Current:    session.ID == payload.ID && !payload.IsAPIKey(),
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+			ExpiresAt:  session.ExpiresAt,
+		})
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "session.list"), list, nil, nil)
+}
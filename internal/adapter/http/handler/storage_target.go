@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type StorageTargetHandler struct {
+	validator *validator.Validate
+	svc       port.StorageTargetService
+}
+
+func NewStorageTargetHandler(svc port.StorageTargetService) *StorageTargetHandler {
+	validator := validator.New(validator.WithRequiredStructEnabled())
+	return &StorageTargetHandler{
+		validator,
+		svc,
+	}
+}
+
+func (sth *StorageTargetHandler) CreateStorageTarget(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateStorageTargetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := sth.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	target := &domain.StorageTarget{
+		ID:            uuid.New(),
+		CustomerID:    req.CustomerID,
+		Name:          req.Name,
+		Kind:          domain.StorageTargetKind(req.Kind),
+		Endpoint:      req.Endpoint,
+		CapacityBytes: req.CapacityBytes,
+		Credentials:   storageTargetCredentials(req.Credentials),
+	}
+
+	err := sth.svc.CreateStorageTarget(r.Context(), target)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, translate(r, "storage_target.created"), storageTargetResponse(target), nil, nil)
+}
+
+func (sth *StorageTargetHandler) GetStorageTarget(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	target, err := sth.svc.GetStorageTarget(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "storage_target.found"), storageTargetResponse(target), nil, nil)
+}
+
+func (sth *StorageTargetHandler) ListStorageTargets(w http.ResponseWriter, r *http.Request) {
+	pageStr := r.URL.Query().Get("page")
+	limitStr := r.URL.Query().Get("limit")
+
+	if pageStr == "" || limitStr == "" {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	page, err := strconv.Atoi(pageStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	var customerID uuid.UUID
+	if customerIDStr := r.URL.Query().Get("customer_id"); customerIDStr != "" {
+		customerID, err = uuid.Parse(customerIDStr)
+		if err != nil {
+			response.Error(w, r, domain.ErrInvalidUUID)
+			return
+		}
+	}
+
+	targets, err := sth.svc.ListStorageTargets(r.Context(), customerID, page, limit)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	list := make([]dto.StorageTargetResponse, 0, len(targets))
+	for _, target := range targets {
+		list = append(list, storageTargetResponse(&target))
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "storage_target.list"), list, nil, nil)
+}
+
+func (sth *StorageTargetHandler) UpdateStorageTarget(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	var req dto.UpdateStorageTargetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := sth.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	target := &domain.StorageTarget{
+		ID:            id,
+		Name:          req.Name,
+		Kind:          domain.StorageTargetKind(req.Kind),
+		Endpoint:      req.Endpoint,
+		CapacityBytes: req.CapacityBytes,
+		Credentials:   storageTargetCredentials(req.Credentials),
+	}
+
+	err = sth.svc.UpdateStorageTarget(r.Context(), target)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, translate(r, "storage_target.updated"), nil, nil, nil)
+}
+
+func (sth *StorageTargetHandler) DeleteStorageTarget(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	err = sth.svc.DeleteStorageTarget(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, translate(r, "storage_target.deleted"), nil, nil, nil)
+}
+
+func (sth *StorageTargetHandler) GetStorageTargetUsage(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	usage, err := sth.svc.GetStorageTargetUsage(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	plans := make([]dto.StorageTargetPlanUsageResponse, 0, len(usage.Plans))
+	for _, plan := range usage.Plans {
+		plans = append(plans, dto.StorageTargetPlanUsageResponse{
+			BackupPlanID:    plan.BackupPlanID,
+			Name:            plan.Name,
+			BackupSizeBytes: plan.BackupSizeBytes,
+			RetainedCopies:  plan.RetainedCopies,
+			ProjectedBytes:  plan.ProjectedBytes,
+		})
+	}
+
+	res := dto.StorageTargetUsageResponse{
+		StorageTargetID: usage.StorageTargetID,
+		CapacityBytes:   usage.CapacityBytes,
+		ProjectedBytes:  usage.ProjectedBytes,
+		AvailableBytes:  usage.AvailableBytes,
+		UsagePercent:    math.Round(usage.UsagePercent*100) / 100,
+		Exceeded:        usage.Exceeds(),
+		Plans:           plans,
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "storage_target.usage"), res, nil, nil)
+}
+
+func storageTargetResponse(target *domain.StorageTarget) dto.StorageTargetResponse {
+	return dto.StorageTargetResponse{
+		ID:             target.ID,
+		CustomerID:     target.CustomerID,
+		Name:           target.Name,
+		Kind:           string(target.Kind),
+		Endpoint:       target.Endpoint,
+		CapacityBytes:  target.CapacityBytes,
+		HasCredentials: target.CredentialsSecretID != nil,
+		CreatedAt:      target.CreatedAt,
+		UpdatedAt:      target.UpdatedAt,
+	}
+}
+
+func storageTargetCredentials(req *dto.StorageTargetCredentialsRequest) *domain.StorageTargetCredentials {
+	if req == nil {
+		return nil
+	}
+	return &domain.StorageTargetCredentials{
+		Username: req.Username,
+		Password: req.Password,
+	}
+}
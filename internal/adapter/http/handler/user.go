@@ -8,6 +8,7 @@ import (
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
 	"github.com/go-chi/chi/v5"
@@ -31,14 +32,13 @@ func NewUserHandler(svc port.UserService) *UserHandler {
 func (uh *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
 	var req dto.UserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.JSON(w, http.StatusBadRequest, "JSON inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidJSON)
 		return
 	}
 	defer r.Body.Close()
 
 	if err := uh.validator.Struct(req); err != nil {
-		errorsMap := utils.ValidationErrorsToMap(err)
-		response.JSON(w, http.StatusBadRequest, "Dados de entrada inválidos", nil, domain.ErrBadRequest.Error(), errorsMap)
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
 		return
 	}
 
@@ -53,37 +53,39 @@ func (uh *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
 
 	err := uh.svc.Register(r.Context(), &user)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
-	response.JSON(w, http.StatusCreated, "Usuário cadastrado com sucesso", nil, nil, nil)
+	response.JSON(w, http.StatusCreated, translate(r, "user.created"), nil, nil, nil)
 }
 
 func (uh *UserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "UUID inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidUUID)
 		return
 	}
 
 	user, err := uh.svc.GetUser(r.Context(), id)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
 	res := dto.UserResponse{
-		ID:        user.ID,
-		Email:     user.Email,
-		Fullname:  user.Fullname,
-		Username:  user.Username,
-		Role:      string(user.Role),
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
-	}
-
-	response.JSON(w, http.StatusOK, "Usuário encontrado", res, nil, nil)
+		ID:                 user.ID,
+		Email:              user.Email,
+		Fullname:           user.Fullname,
+		Username:           user.Username,
+		Role:               string(user.Role),
+		MFAEnabled:         user.MFAEnabled,
+		MustChangePassword: user.MustChangePassword,
+		CreatedAt:          user.CreatedAt,
+		UpdatedAt:          user.UpdatedAt,
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "user.found"), res, nil, nil)
 }
 
 func (uh *UserHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
@@ -91,61 +93,62 @@ func (uh *UserHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
 	limitStr := r.URL.Query().Get("limit")
 
 	if pageStr == "" || limitStr == "" {
-		response.JSON(w, http.StatusBadRequest, "Page e limit são obrigatórios", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidPagination)
 		return
 	}
 
 	page, err := strconv.Atoi(pageStr)
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "Page inválido", nil, err.Error(), nil)
+		response.Error(w, r, domain.ErrInvalidPagination)
 		return
 	}
 
 	limit, err := strconv.Atoi(limitStr)
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "Limit inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidPagination)
 		return
 	}
 
 	users, err := uh.svc.ListUsers(r.Context(), page, limit)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
 	list := make([]dto.UserResponse, 0, len(users))
 	for _, user := range users {
 		list = append(list, dto.UserResponse{
-			ID:        user.ID,
-			Fullname:  user.Fullname,
-			Email:     user.Email,
-			Username:  user.Username,
-			Role:      string(user.Role),
-			CreatedAt: user.CreatedAt,
-			UpdatedAt: user.UpdatedAt,
+			ID:                 user.ID,
+			Fullname:           user.Fullname,
+			Email:              user.Email,
+			Username:           user.Username,
+			Role:               string(user.Role),
+			MFAEnabled:         user.MFAEnabled,
+			MustChangePassword: user.MustChangePassword,
+			CreatedAt:          user.CreatedAt,
+			UpdatedAt:          user.UpdatedAt,
 		})
 	}
 
-	response.JSON(w, http.StatusOK, "Lista de usuários", list, nil, nil)
+	response.JSON(w, http.StatusOK, translate(r, "user.list"), list, nil, nil)
 }
 
 func (uh *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "UUID inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidUUID)
 		return
 	}
 
 	var req dto.UserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.JSON(w, http.StatusBadRequest, "JSON inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidJSON)
 		return
 	}
 	defer r.Body.Close()
 
 	if err := uh.validator.Struct(req); err != nil {
-		errorsMap := utils.ValidationErrorsToMap(err)
-		response.JSON(w, http.StatusBadRequest, "Dados de entrada inválidos", nil, domain.ErrBadRequest.Error(), errorsMap)
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
 		return
 	}
 
@@ -160,25 +163,41 @@ func (uh *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 
 	err = uh.svc.UpdateUser(r.Context(), &user)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
-	response.JSON(w, http.StatusNoContent, "Usuário atualizado", nil, nil, nil)
+	response.JSON(w, http.StatusNoContent, translate(r, "user.updated"), nil, nil, nil)
 }
 
 func (uh *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		response.JSON(w, http.StatusBadRequest, "UUID inválido", nil, nil, nil)
+		response.Error(w, r, domain.ErrInvalidUUID)
 		return
 	}
 
 	err = uh.svc.DeleteUser(r.Context(), id)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, translate(r, "user.deleted"), nil, nil, nil)
+}
+
+func (uh *UserHandler) UnlockUser(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	err = uh.svc.UnlockUser(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
 		return
 	}
 
-	response.JSON(w, http.StatusNoContent, "Usuário deletado com sucesso", nil, nil, nil)
+	response.JSON(w, http.StatusOK, translate(r, "user.unlocked"), nil, nil, nil)
 }
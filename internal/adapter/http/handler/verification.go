@@ -0,0 +1,265 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/dto"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/middlewares"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type VerificationHandler struct {
+	validator *validator.Validate
+	svc       port.VerificationService
+}
+
+func NewVerificationHandler(svc port.VerificationService) *VerificationHandler {
+	validator := validator.New(validator.WithRequiredStructEnabled())
+	return &VerificationHandler{
+		validator,
+		svc,
+	}
+}
+
+func (vh *VerificationHandler) SetVerificationSchedule(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	var req dto.VerificationScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := vh.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	schedule := &domain.VerificationSchedule{
+		BackupPlanID: id,
+		Kind:         domain.VerificationKind(req.Kind),
+		IntervalDays: req.IntervalDays,
+		GraceDays:    req.GraceDays,
+	}
+
+	err = vh.svc.SetVerificationSchedule(r.Context(), schedule)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "verification.schedule_saved"), verificationScheduleResponse(schedule), nil, nil)
+}
+
+func (vh *VerificationHandler) GetVerificationSchedule(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	schedule, err := vh.svc.GetVerificationSchedule(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "verification.schedule_found"), verificationScheduleResponse(schedule), nil, nil)
+}
+
+func (vh *VerificationHandler) DeleteVerificationSchedule(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	err = vh.svc.DeleteVerificationSchedule(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, translate(r, "verification.schedule_deleted"), nil, nil, nil)
+}
+
+func (vh *VerificationHandler) ListVerificationJobs(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	pageStr := r.URL.Query().Get("page")
+	limitStr := r.URL.Query().Get("limit")
+
+	if pageStr == "" || limitStr == "" {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	page, err := strconv.Atoi(pageStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidPagination)
+		return
+	}
+
+	jobs, err := vh.svc.ListVerificationJobs(r.Context(), id, page, limit)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "verification.job_list"), verificationJobsResponse(jobs), nil, nil)
+}
+
+func (vh *VerificationHandler) ListAgentVerificationJobs(w http.ResponseWriter, r *http.Request) {
+	device, ok := middlewares.Device(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrUnauthorized)
+		return
+	}
+
+	jobs, err := vh.svc.ListAgentVerificationJobs(r.Context(), device.ID)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "verification.job_list"), verificationJobsResponse(jobs), nil, nil)
+}
+
+func (vh *VerificationHandler) CompleteVerificationJob(w http.ResponseWriter, r *http.Request) {
+	device, ok := middlewares.Device(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrUnauthorized)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidUUID)
+		return
+	}
+
+	var req dto.VerificationResultRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, r, domain.ErrInvalidJSON)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := vh.validator.Struct(req); err != nil {
+		response.Error(w, r, domain.ErrValidation.WithDetails(utils.ValidationErrorsToMap(i18n.FromContext(r.Context()), err)))
+		return
+	}
+
+	success := domain.VerificationJobStatus(req.Status) == domain.VerificationSucceeded
+	job, err := vh.svc.CompleteVerificationJob(r.Context(), device.ID, id, req.RestorePointID, success, req.Result)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "verification.completed"), verificationJobResponse(job), nil, nil)
+}
+
+// VerificationReport lista a situação de verificação dos planos com agenda;
+// overdue=true devolve apenas os atrasados.
+func (vh *VerificationHandler) VerificationReport(w http.ResponseWriter, r *http.Request) {
+	onlyOverdue := false
+	if overdueStr := r.URL.Query().Get("overdue"); overdueStr != "" {
+		var err error
+		onlyOverdue, err = strconv.ParseBool(overdueStr)
+		if err != nil {
+			response.Error(w, r, domain.ErrValidation.WithDetails(map[string]string{
+				"overdue": i18n.T(i18n.FromContext(r.Context()), "validation.oneof", "overdue", "true false"),
+			}))
+			return
+		}
+	}
+
+	statuses, err := vh.svc.VerificationReport(r.Context(), onlyOverdue, time.Now())
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	list := make([]dto.VerificationStatusResponse, 0, len(statuses))
+	for i := range statuses {
+		list = append(list, *verificationStatusResponse(&statuses[i]))
+	}
+
+	response.JSON(w, http.StatusOK, translate(r, "verification.report"), list, nil, nil)
+}
+
+func verificationScheduleResponse(schedule *domain.VerificationSchedule) dto.VerificationScheduleResponse {
+	return dto.VerificationScheduleResponse{
+		BackupPlanID: schedule.BackupPlanID,
+		Kind:         string(schedule.Kind),
+		IntervalDays: schedule.IntervalDays,
+		GraceDays:    schedule.GraceDays,
+		CreatedAt:    schedule.CreatedAt,
+		UpdatedAt:    schedule.UpdatedAt,
+	}
+}
+
+func verificationStatusResponse(status *domain.VerificationStatus) *dto.VerificationStatusResponse {
+	if status == nil {
+		return nil
+	}
+
+	return &dto.VerificationStatusResponse{
+		BackupPlanID:   status.BackupPlanID,
+		BackupPlanName: status.BackupPlanName,
+		Kind:           string(status.Schedule.Kind),
+		IntervalDays:   status.Schedule.IntervalDays,
+		LastVerifiedAt: status.LastVerifiedAt,
+		DueAt:          status.DueAt,
+		Overdue:        status.Overdue,
+	}
+}
+
+func verificationJobResponse(job *domain.VerificationJob) dto.VerificationJobResponse {
+	return dto.VerificationJobResponse{
+		ID:             job.ID,
+		BackupPlanID:   job.BackupPlanID,
+		Kind:           string(job.Kind),
+		Status:         string(job.Status),
+		ScheduledAt:    job.ScheduledAt,
+		RestorePointID: job.RestorePointID,
+		Result:         job.Result,
+		CompletedAt:    job.CompletedAt,
+		CreatedAt:      job.CreatedAt,
+		UpdatedAt:      job.UpdatedAt,
+	}
+}
+
+func verificationJobsResponse(jobs []domain.VerificationJob) []dto.VerificationJobResponse {
+	list := make([]dto.VerificationJobResponse, 0, len(jobs))
+	for i := range jobs {
+		list = append(list, verificationJobResponse(&jobs[i]))
+	}
+	return list
+}
@@ -0,0 +1,16 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+)
+
+func ClientMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := utils.WithClient(r.Context(), clientIP(r), r.UserAgent())
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
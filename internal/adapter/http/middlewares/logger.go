@@ -0,0 +1,93 @@
+package middlewares
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+const requestInfoKey = contextKey("request_info")
+
+// requestInfo é preenchido ao longo da cadeia de middlewares. Como o logger
+// da requisição é criado antes do roteamento e da autenticação, a rota e o
+// usuário são resolvidos apenas no momento em que cada linha é registrada.
+type requestInfo struct {
+	r         *http.Request
+	requestID string
+	userID    uuid.UUID
+}
+
+type requestLogHandler struct {
+	slog.Handler
+	info *requestInfo
+}
+
+func (h *requestLogHandler) Handle(ctx context.Context, record slog.Record) error {
+	record.AddAttrs(
+		slog.String("request_id", h.info.requestID),
+		slog.String("method", h.info.r.Method),
+		slog.String("route", routePattern(h.info.r)),
+	)
+	if h.info.userID != uuid.Nil {
+		record.AddAttrs(slog.String("user_id", h.info.userID.String()))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *requestLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &requestLogHandler{h.Handler.WithAttrs(attrs), h.info}
+}
+
+func (h *requestLogHandler) WithGroup(name string) slog.Handler {
+	return &requestLogHandler{h.Handler.WithGroup(name), h.info}
+}
+
+func LoggerMiddleware(base *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			info := &requestInfo{
+				r:         r,
+				requestID: middleware.GetReqID(r.Context()),
+			}
+			logger := slog.New(&requestLogHandler{base.Handler(), info})
+
+			ctx := context.WithValue(r.Context(), requestInfoKey, info)
+			ctx = utils.WithLogger(ctx, logger)
+			r = r.WithContext(ctx)
+			info.r = r
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			level := slog.LevelInfo
+			if status >= http.StatusInternalServerError {
+				level = slog.LevelError
+			}
+
+			logger.Log(ctx, level, "Requisição HTTP",
+				slog.String("path", r.URL.Path),
+				slog.Int("status", status),
+				slog.Int64("latency_ms", time.Since(start).Milliseconds()),
+				slog.Int("bytes", ww.BytesWritten()),
+				slog.String("remote_addr", r.RemoteAddr),
+			)
+		})
+	}
+}
+
+func setRequestUser(ctx context.Context, userID uuid.UUID) {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		info.userID = userID
+	}
+}
@@ -0,0 +1,63 @@
+package middlewares
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/metrics"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+func MetricsMiddleware(m *metrics.Metrics) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			m.ObserveRequest(r.Method, routePattern(r), status, time.Since(start))
+		})
+	}
+}
+
+// MetricsAuthMiddleware exige o token de coleta de métricas no cabeçalho
+// Authorization (Bearer <token>).
+func MetricsAuthMiddleware(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fields := strings.Fields(r.Header.Get(authorizationHeaderKey))
+			if len(fields) != 2 || !strings.EqualFold(fields[0], authorizationType) ||
+				subtle.ConstantTimeCompare([]byte(fields[1]), []byte(token)) != 1 {
+				response.Error(w, r, domain.ErrUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// routePattern devolve o padrão da rota do chi (ex.: /customers/{id}) para
+// manter a cardinalidade dos labels sob controle.
+func routePattern(r *http.Request) string {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil {
+		return "unmatched"
+	}
+
+	if pattern := rctx.RoutePattern(); pattern != "" {
+		return pattern
+	}
+	return "unmatched"
+}
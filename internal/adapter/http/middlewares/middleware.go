@@ -2,6 +2,7 @@ package middlewares
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -15,58 +16,131 @@ type contextKey string
 const (
 	authorizationHeaderKey  = "authorization"
 	authorizationType       = "bearer"
+	apiKeyAuthorizationType = "apikey"
+	agentAuthorizationType  = "device"
 	authorizationPayloadKey = contextKey("authorization_payload")
+	agentDeviceKey          = contextKey("agent_device")
 )
 
-func AuthMiddleware(token port.TokenService) func(http.Handler) http.Handler {
+// AuthMiddleware aceita tanto "Bearer <jwt>" quanto "ApiKey <chave>". Chaves
+// de API precisam do escopo read para métodos de leitura e write para os demais;
+// tokens de login só valem enquanto a sessão correspondente estiver ativa.
+func AuthMiddleware(token port.TokenService, apiKeys port.APIKeyService, sessions port.SessionService) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authorizationHeader := r.Header.Get(authorizationHeaderKey)
 
 			isEmpty := len(authorizationHeader) == 0
 			if isEmpty {
-				response.JSON(w, http.StatusUnauthorized, "Falha na autenticação!", nil, domain.ErrEmptyAuthorizationHeader.Error(), nil)
+				response.Error(w, r, domain.ErrEmptyAuthorizationHeader)
 				return
 			}
 
 			fields := strings.Fields(authorizationHeader)
 			isValid := len(fields) == 2
 			if !isValid {
-				response.JSON(w, http.StatusUnauthorized, "Falha na autenticação!", nil, domain.ErrInvalidAuthorizationHeader.Error(), nil)
+				response.Error(w, r, domain.ErrInvalidAuthorizationHeader)
 				return
 			}
 
-			currentAuthorizationType := strings.ToLower(fields[0])
-			if currentAuthorizationType != authorizationType {
-				response.JSON(w, http.StatusUnauthorized, "Falha na autenticação!", nil, domain.ErrInvalidAuthorizationHeader.Error(), nil)
+			var payload *domain.TokenPayload
+			var err error
+			switch strings.ToLower(fields[0]) {
+			case authorizationType:
+				payload, err = token.VerifyToken(fields[1])
+				if err == nil {
+					err = sessions.Validate(r.Context(), payload)
+				}
+			case apiKeyAuthorizationType:
+				payload, err = apiKeys.Authenticate(r.Context(), fields[1])
+			default:
+				response.Error(w, r, domain.ErrInvalidAuthorizationType)
 				return
 			}
-
-			accessToken := fields[1]
-			payload, err := token.VerifyToken(accessToken)
 			if err != nil {
-				response.JSON(w, http.StatusUnauthorized, "Falha na autenticação!", nil, domain.ErrUnauthorized.Error(), nil)
+				var domainErr *domain.Error
+				if !errors.As(err, &domainErr) {
+					domainErr = domain.ErrUnauthorized
+				}
+				response.Error(w, r, domainErr)
+				return
+			}
+
+			if !payload.HasScope(requiredScope(r.Method)) {
+				response.Error(w, r, domain.ErrInsufficientScope)
 				return
 			}
 
+			setRequestUser(r.Context(), payload.UserID)
+
 			ctx := context.WithValue(r.Context(), authorizationPayloadKey, payload)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
-func AdminMiddleware() func(http.Handler) http.Handler {
+// AgentAuthMiddleware autentica o agente do dispositivo por "Device <token>".
+// As rotas do agente não aceitam credenciais de usuário, e vice-versa.
+func AgentAuthMiddleware(agents port.AgentService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorizationHeader := r.Header.Get(authorizationHeaderKey)
+			if len(authorizationHeader) == 0 {
+				response.Error(w, r, domain.ErrEmptyAuthorizationHeader)
+				return
+			}
+
+			fields := strings.Fields(authorizationHeader)
+			if len(fields) != 2 {
+				response.Error(w, r, domain.ErrInvalidAuthorizationHeader)
+				return
+			}
+
+			if strings.ToLower(fields[0]) != agentAuthorizationType {
+				response.Error(w, r, domain.ErrInvalidAuthorizationType)
+				return
+			}
+
+			device, err := agents.Authenticate(r.Context(), fields[1])
+			if err != nil {
+				var domainErr *domain.Error
+				if !errors.As(err, &domainErr) {
+					domainErr = domain.ErrUnauthorized
+				}
+				response.Error(w, r, domainErr)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), agentDeviceKey, device)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// AdminMiddleware restringe a rota a administradores. Com requireMFA, o token
+// também precisa ter sido emitido após a validação do segundo fator.
+func AdminMiddleware(requireMFA bool) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			payload, ok := r.Context().Value(authorizationPayloadKey).(*domain.TokenPayload)
+			payload, ok := Payload(r.Context())
 			if !ok {
-				response.JSON(w, http.StatusUnauthorized, "Falha na autenticação!", nil, domain.ErrInvalidAuthorizationPayload.Error(), nil)
+				response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
 				return
 			}
 
 			isAdmin := payload.Role == domain.Admin
 			if !isAdmin {
-				response.JSON(w, http.StatusForbidden, "Falha na autenticação!", nil, domain.ErrForbidden.Error(), nil)
+				response.Error(w, r, domain.ErrForbidden)
+				return
+			}
+
+			if !payload.HasScope(domain.ScopeAdmin) {
+				response.Error(w, r, domain.ErrInsufficientScope)
+				return
+			}
+
+			if requireMFA && !payload.MFA {
+				response.Error(w, r, domain.ErrMFARequired)
 				return
 			}
 
@@ -74,3 +148,45 @@ func AdminMiddleware() func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// PasswordChangeMiddleware bloqueia tokens emitidos para contas com troca de
+// senha obrigatória; a rota de troca de senha deve ficar fora dele.
+func PasswordChangeMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			payload, ok := Payload(r.Context())
+			if !ok {
+				response.Error(w, r, domain.ErrInvalidAuthorizationPayload)
+				return
+			}
+
+			if payload.PasswordChangeRequired {
+				response.Error(w, r, domain.ErrPasswordChangeRequired)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Payload devolve os dados do token validado pelo AuthMiddleware.
+func Payload(ctx context.Context) (*domain.TokenPayload, bool) {
+	payload, ok := ctx.Value(authorizationPayloadKey).(*domain.TokenPayload)
+	return payload, ok
+}
+
+// Device devolve o dispositivo autenticado pelo AgentAuthMiddleware.
+func Device(ctx context.Context) (*domain.Device, bool) {
+	device, ok := ctx.Value(agentDeviceKey).(*domain.Device)
+	return device, ok
+}
+
+func requiredScope(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return domain.ScopeRead
+	default:
+		return domain.ScopeWrite
+	}
+}
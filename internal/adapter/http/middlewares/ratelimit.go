@@ -0,0 +1,44 @@
+package middlewares
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+)
+
+// RateLimitMiddleware limita as requisições por IP de origem. Falhas no
+// armazenamento dos buckets não bloqueiam a requisição.
+func RateLimitMiddleware(limiter port.RateLimiter, scope string, limit domain.RateLimit) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			key := scope + ":ip:" + clientIP(r)
+
+			allowed, retryAfter, err := limiter.Allow(ctx, key, limit)
+			if err != nil {
+				utils.Logger(ctx).ErrorContext(ctx, "Erro ao consultar rate limit", "error", err.Error())
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if !allowed {
+				response.Error(w, r, domain.ErrTooManyRequests.WithRetryAfter(retryAfter))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
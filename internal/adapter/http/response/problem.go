@@ -0,0 +1,83 @@
+package response
+
+import (
+	"encoding/json"
+	"math"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+)
+
+const problemContentType = "application/problem+json"
+
+// problem segue a RFC 9457. O campo code repete o código estável do erro de
+// domínio para clientes que já tratam o formato padrão da API.
+type problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code"`
+	Errors   any    `json:"errors,omitempty"`
+	TraceID  string `json:"trace_id,omitempty"`
+}
+
+// Error escreve um erro de domínio no formato padrão da API ou, quando o
+// cliente pede application/problem+json no Accept, no formato da RFC 9457.
+// Detalhes por campo que sejam chaves do catálogo são traduzidos.
+func Error(w http.ResponseWriter, r *http.Request, err *domain.Error) {
+	lang := i18n.FromContext(r.Context())
+
+	message := err.Message
+	if i18n.Has(err.Code) {
+		message = i18n.T(lang, err.Code)
+	}
+
+	if details, ok := err.Details.(map[string]string); ok {
+		translated := make(map[string]string, len(details))
+		for field, detail := range details {
+			if i18n.Has(detail) {
+				detail = i18n.T(lang, detail)
+			}
+			translated[field] = detail
+		}
+		err = err.WithDetails(translated)
+	}
+
+	if err.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(err.RetryAfter.Seconds()))))
+	}
+
+	if !wantsProblem(r) {
+		JSON(w, err.Status, message, nil, err.Code, err.Details)
+		return
+	}
+
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(err.Status)
+	json.NewEncoder(w).Encode(problem{
+		Type:     "urn:backup-api:error:" + strings.ToLower(err.Code),
+		Title:    http.StatusText(err.Status),
+		Status:   err.Status,
+		Detail:   message,
+		Instance: r.URL.Path,
+		Code:     err.Code,
+		Errors:   err.Details,
+		TraceID:  w.Header().Get(TraceIDHeader),
+	})
+}
+
+func wantsProblem(r *http.Request) bool {
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err == nil && mediaType == problemContentType {
+			return true
+		}
+	}
+	return false
+}
@@ -5,15 +5,27 @@ import (
 	"net/http"
 )
 
+// TraceIDHeader é preenchido pelo middleware de tracing e repetido no corpo
+// das respostas de erro para facilitar a correlação com os spans.
+const TraceIDHeader = "X-Trace-Id"
+
 type response struct {
 	Status  int    `json:"status"`
 	Message string `json:"message"`
 	Data    any    `json:"data,omitempty"`
 	Error   any    `json:"error,omitempty"`
 	Details any    `json:"details,omitempty"`
+	TraceID string `json:"trace_id,omitempty"`
 }
 
+// JSON escreve o envelope padrão da API. Respostas 204 não têm corpo, então
+// nesse caso só o status é enviado.
 func JSON(w http.ResponseWriter, status int, message string, data any, err any, details any) {
+	if status == http.StatusNoContent {
+		w.WriteHeader(status)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 	response := response{
@@ -23,5 +35,8 @@ func JSON(w http.ResponseWriter, status int, message string, data any, err any,
 		Error:   err,
 		Details: details,
 	}
+	if status >= http.StatusBadRequest {
+		response.TraceID = w.Header().Get(TraceIDHeader)
+	}
 	json.NewEncoder(w).Encode(response)
 }
@@ -5,11 +5,14 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
-	"time"
+	"strconv"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/config"
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/handler"
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/middlewares"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/http/response"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/metrics"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -21,56 +24,184 @@ type router struct {
 }
 
 func NewRouter(
+	cfg *config.HTTP,
 	token port.TokenService,
+	apiKeys port.APIKeyService,
+	sessions port.SessionService,
+	agents port.AgentService,
+	metrics *metrics.Metrics,
+	login *config.Login,
+	mfa *config.MFA,
+	oidc *config.OIDC,
+	limiter port.RateLimiter,
 	healthyHandler handler.HealthCheckHandler,
 	userHandler handler.UserHandler,
 	authHandler handler.AuthHandler,
+	oidcHandler handler.OIDCHandler,
+	mfaHandler handler.MFAHandler,
+	accountHandler handler.AccountHandler,
+	passwordHandler handler.PasswordHandler,
+	apiKeyHandler handler.APIKeyHandler,
+	sessionHandler handler.SessionHandler,
 	customerHandler handler.CustomerHandler,
 	deviceHandler handler.DeviceHandler,
 	backupPlanHandler handler.BackupPlanHandler,
+	retentionPolicyHandler handler.RetentionPolicyHandler,
+	storageTargetHandler handler.StorageTargetHandler,
+	secretHandler handler.SecretHandler,
+	agentHandler handler.AgentHandler,
+	agentCommandHandler handler.AgentCommandHandler,
+	restoreRequestHandler handler.RestoreRequestHandler,
+	restorePointHandler handler.RestorePointHandler,
+	verificationHandler handler.VerificationHandler,
 ) *router {
 	r := chi.NewRouter()
 	r.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   []string{"https://*", "http://*"},
+		AllowedOrigins:   cfg.CORSAllowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedHeaders:   []string{"Accept", "Accept-Language", "Authorization", "Content-Type", "If-None-Match", "X-CSRF-Token", "Traceparent", "Tracestate"},
+		ExposedHeaders:   []string{"ETag", "Link", "Retry-After", response.TraceIDHeader},
 		AllowCredentials: false,
-		MaxAge:           300,
+		MaxAge:           cfg.CORSMaxAge,
 	}))
-	r.Use(middleware.RequestID, middleware.Recoverer)
+	r.Use(
+		middlewares.RealIPMiddleware(cfg.TrustedProxies),
+		middleware.RequestID,
+		middlewares.TracingMiddleware(),
+		middlewares.LoggerMiddleware(slog.Default()),
+		middlewares.LanguageMiddleware(),
+		middlewares.ClientMiddleware(),
+		// Fora do Recoverer, para que os panics apareçam como 500 nas métricas.
+		middlewares.MetricsMiddleware(metrics),
+		middleware.Recoverer,
+	)
 
 	r.Get("/health", healthyHandler.Health)
-	r.Post("/login", authHandler.Login)
+	r.Get("/livez", healthyHandler.Live)
+	r.Get("/readyz", healthyHandler.Ready)
+	if cfg.MetricsToken != "" {
+		r.With(middlewares.MetricsAuthMiddleware(cfg.MetricsToken)).Method(http.MethodGet, "/metrics", metrics.Handler())
+	}
+	loginRateLimit := middlewares.RateLimitMiddleware(limiter, "login", domain.RateLimit{
+		Burst:  login.IPBurst,
+		Refill: login.IPRefill,
+	})
+	r.With(loginRateLimit).Post("/login", authHandler.Login)
+	r.With(loginRateLimit).Post("/auth/mfa/verify", authHandler.VerifyMFA)
+	r.With(loginRateLimit).Post("/auth/forgot-password", accountHandler.ForgotPassword)
+	r.With(loginRateLimit).Post("/auth/reset-password", accountHandler.ResetPassword)
+	r.With(loginRateLimit).Post("/auth/verify-email", accountHandler.VerifyEmail)
+	r.With(loginRateLimit).Post("/auth/resend-verification", accountHandler.ResendVerification)
+	if oidc.Enabled {
+		r.With(loginRateLimit).Get("/auth/oidc/login", oidcHandler.Login)
+		r.With(loginRateLimit).Get("/auth/oidc/callback", oidcHandler.Callback)
+	}
+	r.Group(func(r chi.Router) {
+		r.Use(middlewares.AgentAuthMiddleware(agents))
+		r.Get("/agent/config", agentHandler.GetAgentConfig)
+		r.Get("/agent/commands", agentCommandHandler.FetchAgentCommands)
+		r.Post("/agent/commands/{id}/ack", agentCommandHandler.AcknowledgeAgentCommand)
+		r.Post("/agent/commands/{id}/result", agentCommandHandler.CompleteAgentCommand)
+		r.Get("/agent/restores", restoreRequestHandler.ListAgentRestores)
+		r.Post("/agent/restores/{id}/start", restoreRequestHandler.StartRestore)
+		r.Post("/agent/restores/{id}/result", restoreRequestHandler.CompleteRestore)
+		r.Post("/agent/restore_points", restorePointHandler.ReportRestorePoints)
+		r.Delete("/agent/restore_points/{id}", restorePointHandler.DeleteAgentRestorePoint)
+		r.Get("/agent/verifications", verificationHandler.ListAgentVerificationJobs)
+		r.Post("/agent/verifications/{id}/result", verificationHandler.CompleteVerificationJob)
+	})
 	r.Group(func(r chi.Router) {
-		r.Use(middlewares.AuthMiddleware(token))
-		r.Post("/register", userHandler.Register)
-		r.Get("/users/{id}", userHandler.GetUser)
-		r.Put("/users/{id}", userHandler.UpdateUser)
+		r.Use(middlewares.AuthMiddleware(token, apiKeys, sessions))
+		r.Post("/me/password", passwordHandler.ChangePassword)
 
 		r.Group(func(r chi.Router) {
-			r.Use(middlewares.AdminMiddleware())
-			r.Get("/users", userHandler.ListUsers)
-			r.Delete("/users/{id}", userHandler.DeleteUser)
-		})
+			r.Use(middlewares.PasswordChangeMiddleware())
+			r.Post("/register", userHandler.Register)
+			r.Get("/users/{id}", userHandler.GetUser)
+			r.Put("/users/{id}", userHandler.UpdateUser)
+
+			r.Post("/me/mfa/totp", mfaHandler.EnrollTOTP)
+			r.Post("/me/mfa/totp/verify", mfaHandler.ConfirmTOTP)
+			r.Delete("/me/mfa/totp", mfaHandler.DisableTOTP)
+
+			r.Get("/me/api-keys", apiKeyHandler.ListMyAPIKeys)
+			r.Post("/me/api-keys", apiKeyHandler.CreateAPIKey)
+			r.Delete("/me/api-keys/{id}", apiKeyHandler.RevokeMyAPIKey)
+
+			r.Get("/me/sessions", sessionHandler.ListMySessions)
+			r.Delete("/me/sessions/{id}", sessionHandler.RevokeMySession)
 
-		r.Post("/customers", customerHandler.CreateCustomer)
-		r.Get("/customers/{id}", customerHandler.GetCustomer)
-		r.Get("/customers", customerHandler.ListCustomers)
-		r.Put("/customers/{id}", customerHandler.UpdateCustomer)
-		r.Delete("/customers/{id}", customerHandler.DeleteCustomer)
-
-		r.Post("/devices", deviceHandler.CreateDevice)
-		r.Get("/devices/{id}", deviceHandler.GetDevice)
-		r.Get("/devices", deviceHandler.ListDevices)
-		r.Put("/devices/{id}", deviceHandler.UpdateDevice)
-		r.Delete("/devices/{id}", deviceHandler.DeleteDevice)
-
-		r.Post("/backup_plans", backupPlanHandler.CreateBackupPlan)
-		r.Get("/backup_plans/{id}", backupPlanHandler.GetBackupPlan)
-		r.Get("/backup_plans", backupPlanHandler.ListBackupPlans)
-		r.Put("/backup_plans/{id}", backupPlanHandler.UpdateBackupPlan)
-		r.Delete("/backup_plans/{id}", backupPlanHandler.DeleteBackupPlan)
+			if oidc.Enabled {
+				r.Post("/me/oidc/link", oidcHandler.Link)
+			}
+
+			r.Group(func(r chi.Router) {
+				r.Use(middlewares.AdminMiddleware(mfa.RequireForAdmin))
+				r.Get("/users", userHandler.ListUsers)
+				r.Delete("/users/{id}", userHandler.DeleteUser)
+				r.Post("/users/{id}/unlock", userHandler.UnlockUser)
+				r.Post("/users/{id}/force-password-change", passwordHandler.ForcePasswordChange)
+				r.Get("/api-keys", apiKeyHandler.ListAPIKeys)
+				r.Delete("/api-keys/{id}", apiKeyHandler.RevokeAPIKey)
+				r.Get("/users/{id}/sessions", sessionHandler.ListUserSessions)
+				r.Delete("/users/{id}/sessions", sessionHandler.RevokeUserSessions)
+				r.Delete("/users/{id}/sessions/{session_id}", sessionHandler.RevokeUserSession)
+				r.Post("/secrets/rotate-master-key", secretHandler.RotateMasterKey)
+				r.Post("/restore_requests/{id}/approve", restoreRequestHandler.ApproveRestoreRequest)
+				r.Post("/restore_requests/{id}/reject", restoreRequestHandler.RejectRestoreRequest)
+			})
+
+			r.Post("/customers", customerHandler.CreateCustomer)
+			r.Get("/customers/{id}", customerHandler.GetCustomer)
+			r.Get("/customers", customerHandler.ListCustomers)
+			r.Put("/customers/{id}", customerHandler.UpdateCustomer)
+			r.Delete("/customers/{id}", customerHandler.DeleteCustomer)
+
+			r.Post("/devices", deviceHandler.CreateDevice)
+			r.Get("/devices/{id}", deviceHandler.GetDevice)
+			r.Get("/devices", deviceHandler.ListDevices)
+			r.Put("/devices/{id}", deviceHandler.UpdateDevice)
+			r.Delete("/devices/{id}", deviceHandler.DeleteDevice)
+			r.Post("/devices/{id}/agent-token", agentHandler.IssueAgentToken)
+			r.Delete("/devices/{id}/agent-token", agentHandler.RevokeAgentToken)
+			r.Get("/devices/{id}/restore_points", restorePointHandler.ListDeviceRestorePoints)
+			r.Post("/devices/{id}/commands", agentCommandHandler.CreateAgentCommand)
+			r.Get("/devices/{id}/commands", agentCommandHandler.ListAgentCommands)
+			r.Get("/devices/{id}/commands/{command_id}", agentCommandHandler.GetAgentCommand)
+
+			r.Post("/backup_plans", backupPlanHandler.CreateBackupPlan)
+			r.Get("/backup_plans/{id}", backupPlanHandler.GetBackupPlan)
+			r.Get("/backup_plans", backupPlanHandler.ListBackupPlans)
+			r.Put("/backup_plans/{id}", backupPlanHandler.UpdateBackupPlan)
+			r.Delete("/backup_plans/{id}", backupPlanHandler.DeleteBackupPlan)
+			r.Post("/backup_plans/{id}/retention/evaluate", retentionPolicyHandler.EvaluateBackupPlanRetention)
+			r.Get("/backup_plans/{id}/restore_points", restorePointHandler.ListRestorePoints)
+			r.Get("/backup_plans/{id}/size_history", restorePointHandler.GetSizeHistory)
+			r.Put("/backup_plans/{id}/verification_schedule", verificationHandler.SetVerificationSchedule)
+			r.Get("/backup_plans/{id}/verification_schedule", verificationHandler.GetVerificationSchedule)
+			r.Delete("/backup_plans/{id}/verification_schedule", verificationHandler.DeleteVerificationSchedule)
+			r.Get("/backup_plans/{id}/verifications", verificationHandler.ListVerificationJobs)
+			r.Get("/reports/verification", verificationHandler.VerificationReport)
+			r.Get("/size_alerts", restorePointHandler.ListSizeAlerts)
+
+			r.Post("/retention_policies", retentionPolicyHandler.CreateRetentionPolicy)
+			r.Get("/retention_policies/{id}", retentionPolicyHandler.GetRetentionPolicy)
+			r.Get("/retention_policies", retentionPolicyHandler.ListRetentionPolicies)
+			r.Put("/retention_policies/{id}", retentionPolicyHandler.UpdateRetentionPolicy)
+			r.Delete("/retention_policies/{id}", retentionPolicyHandler.DeleteRetentionPolicy)
+			r.Post("/retention_policies/{id}/evaluate", retentionPolicyHandler.EvaluateRetentionPolicy)
+
+			r.Post("/storage_targets", storageTargetHandler.CreateStorageTarget)
+			r.Get("/storage_targets/{id}", storageTargetHandler.GetStorageTarget)
+			r.Get("/storage_targets", storageTargetHandler.ListStorageTargets)
+			r.Put("/storage_targets/{id}", storageTargetHandler.UpdateStorageTarget)
+			r.Delete("/storage_targets/{id}", storageTargetHandler.DeleteStorageTarget)
+			r.Get("/storage_targets/{id}/usage", storageTargetHandler.GetStorageTargetUsage)
+
+			r.Post("/restore_requests", restoreRequestHandler.CreateRestoreRequest)
+			r.Get("/restore_requests/{id}", restoreRequestHandler.GetRestoreRequest)
+			r.Get("/restore_requests", restoreRequestHandler.ListRestoreRequests)
+		})
 	})
 
 	return &router{
@@ -80,16 +211,15 @@ func NewRouter(
 
 func (r *router) Serve(ctx context.Context, cfg *config.HTTP) error {
 	server := &http.Server{
-		Addr:         ":" + cfg.Port,
+		Addr:         ":" + strconv.Itoa(cfg.Port),
 		Handler:      r,
-		ReadTimeout:  5 * time.Minute,
-		WriteTimeout: 5 * time.Minute,
-		IdleTimeout:  time.Minute,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		IdleTimeout:  cfg.IdleTimeout,
 	}
 
 	defer func() {
-		const timeout = 30 * time.Second
-		ctx, cancel := context.WithTimeout(ctx, timeout)
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cfg.ShutdownTimeout)
 		defer cancel()
 
 		if err := server.Shutdown(ctx); err != nil {
@@ -0,0 +1,21 @@
+package logger
+
+import (
+	"io"
+	"log/slog"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/config"
+)
+
+func NewHandler(w io.Writer, cfg *config.Log) slog.Handler {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		level = slog.LevelInfo
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+	if cfg.Format == "text" {
+		return slog.NewTextHandler(w, opts)
+	}
+	return slog.NewJSONHandler(w, opts)
+}
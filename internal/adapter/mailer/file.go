@@ -0,0 +1,36 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+)
+
+// FileMailer grava cada mensagem como um arquivo .eml, útil em
+// desenvolvimento para abrir os links enviados sem um servidor SMTP.
+type FileMailer struct {
+	from string
+	dir  string
+}
+
+func NewFileMailer(from, dir string) (*FileMailer, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &FileMailer{
+		from,
+		dir,
+	}, nil
+}
+
+func (fm *FileMailer) Send(ctx context.Context, email domain.Email) error {
+	now := time.Now()
+	name := fmt.Sprintf("%s-%d.eml", now.Format("20060102T150405"), now.UnixNano())
+
+	return os.WriteFile(filepath.Join(fm.dir, name), build(fm.from, email, now), 0o640)
+}
@@ -0,0 +1,21 @@
+package mailer
+
+import (
+	"context"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+)
+
+// LogMailer apenas registra a mensagem no log. Não use em produção: o corpo
+// contém tokens de uso único.
+type LogMailer struct{}
+
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+func (lm *LogMailer) Send(ctx context.Context, email domain.Email) error {
+	utils.Logger(ctx).InfoContext(ctx, "E-mail não enviado (MAIL_DRIVER=log)", "to", email.To, "subject", email.Subject, "body", email.Body)
+	return nil
+}
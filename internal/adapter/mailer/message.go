@@ -0,0 +1,59 @@
+package mailer
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"mime"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+)
+
+// build monta a mensagem em texto puro UTF-8 com quebras de linha CRLF,
+// formato aceito tanto por servidores SMTP quanto por leitores de .eml.
+func build(from string, email domain.Email, now time.Time) []byte {
+	var buf bytes.Buffer
+
+	header := func(key, value string) {
+		buf.WriteString(key + ": " + value + "\r\n")
+	}
+
+	header("From", from)
+	header("To", email.To)
+	header("Subject", mime.QEncoding.Encode("utf-8", email.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("Message-ID", messageID(from))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=UTF-8")
+	header("Content-Transfer-Encoding", "8bit")
+	buf.WriteString("\r\n")
+
+	body := strings.ReplaceAll(email.Body, "\r\n", "\n")
+	buf.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	return buf.Bytes()
+}
+
+func messageID(from string) string {
+	domainPart := "localhost"
+	if addr, err := mail.ParseAddress(from); err == nil {
+		if at := strings.LastIndex(addr.Address, "@"); at >= 0 {
+			domainPart = addr.Address[at+1:]
+		}
+	}
+
+	raw := make([]byte, 16)
+	rand.Read(raw)
+	return "<" + hex.EncodeToString(raw) + "@" + domainPart + ">"
+}
+
+func address(value string) (string, error) {
+	addr, err := mail.ParseAddress(value)
+	if err != nil {
+		return "", err
+	}
+	return addr.Address, nil
+}
@@ -0,0 +1,99 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/config"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+)
+
+type SMTPMailer struct {
+	config *config.Mail
+}
+
+func NewSMTPMailer(config *config.Mail) *SMTPMailer {
+	return &SMTPMailer{
+		config,
+	}
+}
+
+// Send abre uma conexão por mensagem. O volume de e-mails da API é baixo e
+// isso evita manter sessões SMTP ociosas.
+func (sm *SMTPMailer) Send(ctx context.Context, email domain.Email) error {
+	from, err := address(sm.config.From)
+	if err != nil {
+		return err
+	}
+
+	to, err := address(email.To)
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(sm.config.SMTPHost, strconv.Itoa(sm.config.SMTPPort))
+	dialer := &net.Dialer{Timeout: sm.config.SMTPTimeout}
+
+	var conn net.Conn
+	if sm.config.SMTPTLS == "tls" {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, &tls.Config{ServerName: sm.config.SMTPHost})
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(sm.config.SMTPTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetDeadline(deadline)
+
+	client, err := smtp.NewClient(conn, sm.config.SMTPHost)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if sm.config.SMTPTLS == "starttls" {
+		if err := client.StartTLS(&tls.Config{ServerName: sm.config.SMTPHost}); err != nil {
+			return err
+		}
+	}
+
+	if sm.config.SMTPUser != "" {
+		auth := smtp.PlainAuth("", sm.config.SMTPUser, sm.config.SMTPPass, sm.config.SMTPHost)
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(build(sm.config.From, email, time.Now())); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
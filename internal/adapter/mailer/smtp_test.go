@@ -0,0 +1,157 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/config"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+)
+
+// smtpMessage é o que o servidor de teste recebeu em uma sessão.
+type smtpMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+// startSMTPServer sobe um servidor SMTP mínimo em 127.0.0.1 que aceita uma
+// única sessão sem TLS nem autenticação.
+func startSMTPServer(t *testing.T) (string, int, <-chan smtpMessage) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	messages := make(chan smtpMessage, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) {
+			conn.Write([]byte(line + "\r\n"))
+		}
+
+		var msg smtpMessage
+		reply("220 localhost ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			command := strings.ToUpper(line)
+
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(command, "MAIL FROM:"):
+				msg.from = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+				reply("250 OK")
+			case strings.HasPrefix(command, "RCPT TO:"):
+				msg.to = append(msg.to, strings.Trim(line[len("RCPT TO:"):], "<> "))
+				reply("250 OK")
+			case command == "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				var data strings.Builder
+				for {
+					dataLine, err := reader.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if dataLine == ".\r\n" {
+						break
+					}
+					data.WriteString(dataLine)
+				}
+				msg.data = data.String()
+				reply("250 OK")
+			case command == "QUIT":
+				reply("221 Bye")
+				messages <- msg
+				return
+			default:
+				reply("502 Command not implemented")
+			}
+		}
+	}()
+
+	host, portStr, _ := net.SplitHostPort(listener.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+	return host, port, messages
+}
+
+func TestSMTPMailerSend(t *testing.T) {
+	host, port, messages := startSMTPServer(t)
+
+	mailer := NewSMTPMailer(&config.Mail{
+		From:        "Backups <no-reply@example.com>",
+		SMTPHost:    host,
+		SMTPPort:    port,
+		SMTPTLS:     "none",
+		SMTPTimeout: 5 * time.Second,
+	})
+
+	err := mailer.Send(context.Background(), domain.Email{
+		To:      "Maria <maria@example.com>",
+		Subject: "Redefinição de senha",
+		Body:    "Olá, Maria\nUse o link abaixo.",
+	})
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	var msg smtpMessage
+	select {
+	case msg = <-messages:
+	case <-time.After(5 * time.Second):
+		t.Fatal("o servidor não recebeu a mensagem")
+	}
+
+	if msg.from != "no-reply@example.com" {
+		t.Errorf("MAIL FROM = %q, want no-reply@example.com", msg.from)
+	}
+	if len(msg.to) != 1 || msg.to[0] != "maria@example.com" {
+		t.Errorf("RCPT TO = %v, want [maria@example.com]", msg.to)
+	}
+
+	for _, want := range []string{
+		"From: Backups <no-reply@example.com>\r\n",
+		"To: Maria <maria@example.com>\r\n",
+		"Subject: =?utf-8?q?Redefini=C3=A7=C3=A3o_de_senha?=\r\n",
+		"Content-Type: text/plain; charset=UTF-8\r\n",
+		"\r\n\r\nOlá, Maria\r\nUse o link abaixo.",
+	} {
+		if !strings.Contains(msg.data, want) {
+			t.Errorf("mensagem sem %q:\n%s", want, msg.data)
+		}
+	}
+}
+
+func TestSMTPMailerSendInvalidRecipient(t *testing.T) {
+	mailer := NewSMTPMailer(&config.Mail{
+		From:        "no-reply@example.com",
+		SMTPHost:    "127.0.0.1",
+		SMTPPort:    1,
+		SMTPTLS:     "none",
+		SMTPTimeout: time.Second,
+	})
+
+	err := mailer.Send(context.Background(), domain.Email{To: "não é um e-mail", Subject: "x", Body: "x"})
+	if err == nil {
+		t.Fatal("Send aceitou um destinatário inválido")
+	}
+}
@@ -0,0 +1,202 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/health"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const collectTimeout = 5 * time.Second
+
+type poolCollector struct {
+	db *postgres.DB
+
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	acquireCount         *prometheus.Desc
+	emptyAcquireCount    *prometheus.Desc
+	canceledAcquireCount *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+}
+
+func newPoolCollector(db *postgres.DB) *poolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+
+	return &poolCollector{
+		db:                   db,
+		acquiredConns:        desc("acquired_conns", "Conexões atualmente em uso."),
+		idleConns:            desc("idle_conns", "Conexões ociosas no pool."),
+		totalConns:           desc("total_conns", "Total de conexões abertas no pool."),
+		maxConns:             desc("max_conns", "Tamanho máximo do pool."),
+		acquireCount:         desc("acquire_total", "Total de conexões obtidas do pool."),
+		emptyAcquireCount:    desc("empty_acquire_total", "Aquisições que precisaram esperar por uma conexão livre."),
+		canceledAcquireCount: desc("canceled_acquire_total", "Aquisições canceladas pelo contexto."),
+		acquireDuration:      desc("acquire_wait_seconds_total", "Tempo total gasto aguardando conexões do pool."),
+	}
+}
+
+func (pc *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- pc.acquiredConns
+	ch <- pc.idleConns
+	ch <- pc.totalConns
+	ch <- pc.maxConns
+	ch <- pc.acquireCount
+	ch <- pc.emptyAcquireCount
+	ch <- pc.canceledAcquireCount
+	ch <- pc.acquireDuration
+}
+
+func (pc *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := pc.db.Stat()
+
+	ch <- prometheus.MustNewConstMetric(pc.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(pc.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(pc.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(pc.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(pc.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(pc.emptyAcquireCount, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(pc.canceledAcquireCount, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(pc.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+}
+
+type workerCollector struct {
+	workers *health.Workers
+
+	runs                *prometheus.Desc
+	failures            *prometheus.Desc
+	consecutiveFailures *prometheus.Desc
+	lastBeat            *prometheus.Desc
+}
+
+func newWorkerCollector(workers *health.Workers) *workerCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "worker", name), help, []string{"worker"}, nil)
+	}
+
+	return &workerCollector{
+		workers:             workers,
+		runs:                desc("runs_total", "Total de execuções do worker."),
+		failures:            desc("failures_total", "Total de execuções do worker que falharam."),
+		consecutiveFailures: desc("consecutive_failures", "Falhas seguidas desde a última execução bem-sucedida."),
+		lastBeat:            desc("last_beat_timestamp_seconds", "Momento da última execução do worker."),
+	}
+}
+
+func (wc *workerCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- wc.runs
+	ch <- wc.failures
+	ch <- wc.consecutiveFailures
+	ch <- wc.lastBeat
+}
+
+func (wc *workerCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, stats := range wc.workers.Stats() {
+		ch <- prometheus.MustNewConstMetric(wc.runs, prometheus.CounterValue, float64(stats.Runs), stats.Name)
+		ch <- prometheus.MustNewConstMetric(wc.failures, prometheus.CounterValue, float64(stats.Failures), stats.Name)
+		ch <- prometheus.MustNewConstMetric(wc.consecutiveFailures, prometheus.GaugeValue, float64(stats.ConsecutiveFailures), stats.Name)
+		ch <- prometheus.MustNewConstMetric(wc.lastBeat, prometheus.GaugeValue, float64(stats.LastBeat.Unix()), stats.Name)
+	}
+}
+
+// domainCollector consulta os repositórios a cada coleta, então os valores
+// refletem sempre o estado atual do banco. Os gauges por status trazem todos
+// os status conhecidos, zerados quando não há registros, para que a série não
+// desapareça.
+type domainCollector struct {
+	customerRepo       port.CustomerRepository
+	deviceRepo         port.DeviceRepository
+	backupPlanRepo     port.BackupPlanRepository
+	verificationRepo   port.VerificationRepository
+	restoreRequestRepo port.RestoreRequestRepository
+	agentCommandRepo   port.AgentCommandRepository
+
+	customers        *prometheus.Desc
+	devices          *prometheus.Desc
+	backupPlans      *prometheus.Desc
+	verificationJobs *prometheus.Desc
+	restoreRequests  *prometheus.Desc
+	agentCommands    *prometheus.Desc
+}
+
+func newDomainCollector(
+	customerRepo port.CustomerRepository,
+	deviceRepo port.DeviceRepository,
+	backupPlanRepo port.BackupPlanRepository,
+	verificationRepo port.VerificationRepository,
+	restoreRequestRepo port.RestoreRequestRepository,
+	agentCommandRepo port.AgentCommandRepository,
+) *domainCollector {
+	return &domainCollector{
+		customerRepo:       customerRepo,
+		deviceRepo:         deviceRepo,
+		backupPlanRepo:     backupPlanRepo,
+		verificationRepo:   verificationRepo,
+		restoreRequestRepo: restoreRequestRepo,
+		agentCommandRepo:   agentCommandRepo,
+		customers:          prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "customers"), "Quantidade de clientes cadastrados.", nil, nil),
+		devices:            prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "devices"), "Quantidade de dispositivos cadastrados.", nil, nil),
+		backupPlans:        prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "backup_plans"), "Quantidade de planos de backup cadastrados.", nil, nil),
+		verificationJobs:   prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "verification_jobs"), "Quantidade de verificações de restauração por status.", []string{"status"}, nil),
+		restoreRequests:    prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "restore_requests"), "Quantidade de pedidos de restauração por status.", []string{"status"}, nil),
+		agentCommands:      prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "agent_commands"), "Quantidade de comandos para agentes por status.", []string{"status"}, nil),
+	}
+}
+
+func (dc *domainCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- dc.customers
+	ch <- dc.devices
+	ch <- dc.backupPlans
+	ch <- dc.verificationJobs
+	ch <- dc.restoreRequests
+	ch <- dc.agentCommands
+}
+
+func (dc *domainCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	gauge := func(desc *prometheus.Desc, count func(context.Context) (int, error)) {
+		value, err := count(ctx)
+		if err != nil {
+			slog.Error("Erro ao coletar métrica", "metric", desc.String(), "error", err)
+			ch <- prometheus.NewInvalidMetric(desc, err)
+			return
+		}
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(value))
+	}
+
+	gauge(dc.customers, dc.customerRepo.CountCustomers)
+	gauge(dc.devices, dc.deviceRepo.CountDevices)
+	gauge(dc.backupPlans, dc.backupPlanRepo.CountBackupPlans)
+
+	statusGauge(ctx, ch, dc.verificationJobs, dc.verificationRepo.CountVerificationJobsByStatus,
+		domain.VerificationPending, domain.VerificationSucceeded, domain.VerificationFailed)
+	statusGauge(ctx, ch, dc.restoreRequests, dc.restoreRequestRepo.CountRestoreRequestsByStatus,
+		domain.RestoreRequested, domain.RestoreApproved, domain.RestoreRejected, domain.RestoreInProgress, domain.RestoreCompleted, domain.RestoreFailed)
+	statusGauge(ctx, ch, dc.agentCommands, dc.agentCommandRepo.CountAgentCommandsByStatus,
+		domain.AgentCommandPending, domain.AgentCommandAcknowledged, domain.AgentCommandSucceeded, domain.AgentCommandFailed, domain.AgentCommandExpired)
+}
+
+// statusGauge emite um valor por status, incluindo os status sem registros.
+func statusGauge[S ~string](ctx context.Context, ch chan<- prometheus.Metric, desc *prometheus.Desc, count func(context.Context) (map[S]int, error), statuses ...S) {
+	counts, err := count(ctx)
+	if err != nil {
+		slog.Error("Erro ao coletar métrica", "metric", desc.String(), "error", err)
+		ch <- prometheus.NewInvalidMetric(desc, err)
+		return
+	}
+
+	for _, status := range statuses {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(counts[status]), string(status))
+	}
+}
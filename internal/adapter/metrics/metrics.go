@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/health"
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "backup_api"
+
+type Metrics struct {
+	registry        *prometheus.Registry
+	requestsTotal   *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+}
+
+func New(
+	db *postgres.DB,
+	workers *health.Workers,
+	customerRepo port.CustomerRepository,
+	deviceRepo port.DeviceRepository,
+	backupPlanRepo port.BackupPlanRepository,
+	verificationRepo port.VerificationRepository,
+	restoreRequestRepo port.RestoreRequestRepository,
+	agentCommandRepo port.AgentCommandRepository,
+) *Metrics {
+	registry := prometheus.NewRegistry()
+
+	requestsTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Total de requisições HTTP por rota, método e status.",
+	}, []string{"method", "route", "status"})
+
+	requestDuration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latência das requisições HTTP por rota, método e status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		requestsTotal,
+		requestDuration,
+		newPoolCollector(db),
+		newWorkerCollector(workers),
+		newDomainCollector(customerRepo, deviceRepo, backupPlanRepo, verificationRepo, restoreRequestRepo, agentCommandRepo),
+	)
+
+	return &Metrics{
+		registry,
+		requestsTotal,
+		requestDuration,
+	}
+}
+
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *Metrics) ObserveRequest(method, route string, status int, duration time.Duration) {
+	statusStr := strconv.Itoa(status)
+	m.requestsTotal.WithLabelValues(method, route, statusStr).Inc()
+	m.requestDuration.WithLabelValues(method, route, statusStr).Observe(duration.Seconds())
+}
@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+)
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	limit     domain.RateLimit
+}
+
+// MemoryLimiter guarda os buckets no processo. Serve para uma única réplica;
+// com várias réplicas use o armazenamento em Postgres.
+type MemoryLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets: make(map[string]*bucket),
+	}
+}
+
+func (ml *MemoryLimiter) Allow(ctx context.Context, key string, limit domain.RateLimit) (bool, time.Duration, error) {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+
+	now := time.Now()
+	b, ok := ml.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updatedAt: now}
+		ml.buckets[key] = b
+	}
+
+	tokens, allowed, retryAfter := limit.Take(b.tokens, now.Sub(b.updatedAt))
+	b.tokens = tokens
+	b.updatedAt = now
+	b.limit = limit
+
+	return allowed, retryAfter, nil
+}
+
+// Sweep descarta buckets que já estariam cheios novamente.
+func (ml *MemoryLimiter) Sweep(ctx context.Context) error {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+
+	now := time.Now()
+	for key, b := range ml.buckets {
+		full := time.Duration((float64(b.limit.Burst) - b.tokens) * float64(b.limit.Refill))
+		if now.Sub(b.updatedAt) >= full {
+			delete(ml.buckets, key)
+		}
+	}
+
+	return nil
+}
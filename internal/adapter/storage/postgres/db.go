@@ -2,8 +2,11 @@ package postgres
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"log/slog"
+	"net"
+	"net/url"
+	"strconv"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/config"
 	"github.com/golang-migrate/migrate/v4"
@@ -12,20 +15,38 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+const migrationsPath = "./internal/adapter/storage/postgres/migrations"
+
 type DB struct {
 	*pgxpool.Pool
+	expectedVersion uint
 }
 
 func New(ctx context.Context, config *config.DB) (*DB, error) {
-	connString := fmt.Sprintf(
-		"postgres://%s:%s@%s:%s/%s?sslmode=disable",
-		config.User,
-		config.Pass,
-		config.Host,
-		config.Port,
-		config.Name)
-	db, err := pgxpool.New(ctx, connString)
+	connString, err := ConnString(config)
+	if err != nil {
+		slog.Error("URL do banco de dados inválida")
+		return nil, err
+	}
+
+	poolConfig, err := pgxpool.ParseConfig(connString)
+	if err != nil {
+		slog.Error("Configuração do banco de dados inválida")
+		return nil, err
+	}
+
+	poolConfig.MaxConns = int32(config.MaxConns)
+	poolConfig.MinConns = int32(config.MinConns)
+	poolConfig.MaxConnLifetime = config.MaxConnLifetime
+	poolConfig.MaxConnIdleTime = config.MaxConnIdleTime
+	poolConfig.HealthCheckPeriod = config.HealthCheckPeriod
+	poolConfig.ConnConfig.ConnectTimeout = config.ConnectTimeout
+	poolConfig.ConnConfig.Tracer = &queryTracer{}
+	if config.StatementTimeout > 0 {
+		poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(config.StatementTimeout.Milliseconds(), 10)
+	}
 
+	db, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		slog.Error("Falha ao conectar no banco de dados")
 		return nil, err
@@ -36,18 +57,61 @@ func New(ctx context.Context, config *config.DB) (*DB, error) {
 		return nil, err
 	}
 
-	m, err := migrate.New("file://./internal/adapter/storage/postgres/migrations", connString)
+	expectedVersion, err := latestMigrationVersion(migrationsPath)
+	if err != nil {
+		slog.Error("Falha ao ler o diretório de migrations")
+		return nil, err
+	}
+
+	m, err := migrate.New("file://"+migrationsPath, connString)
 	if err != nil {
 		slog.Error("Falha ao criar a instancia do migrate")
 		return nil, err
 	}
 
-	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
 		slog.Error("Falha ao executar as migrations")
 		return nil, err
 	}
 
 	return &DB{
 		db,
+		expectedVersion,
 	}, nil
 }
+
+// ConnString monta a URL de conexão a partir de DATABASE_URL ou dos campos
+// individuais. Parâmetros de TLS e application_name só são aplicados quando
+// a URL ainda não os define, e as credenciais são sempre escapadas.
+func ConnString(config *config.DB) (string, error) {
+	var u *url.URL
+	if config.URL != "" {
+		parsed, err := url.Parse(config.URL)
+		if err != nil {
+			return "", err
+		}
+		u = parsed
+	} else {
+		u = &url.URL{
+			Scheme: "postgres",
+			User:   url.UserPassword(config.User, config.Pass),
+			Host:   net.JoinHostPort(config.Host, strconv.Itoa(config.Port)),
+			Path:   "/" + config.Name,
+		}
+	}
+
+	query := u.Query()
+	setDefault := func(key, value string) {
+		if value != "" && query.Get(key) == "" {
+			query.Set(key, value)
+		}
+	}
+	setDefault("sslmode", config.SSLMode)
+	setDefault("sslrootcert", config.SSLRootCert)
+	setDefault("sslcert", config.SSLCert)
+	setDefault("sslkey", config.SSLKey)
+	setDefault("application_name", config.ApplicationName)
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
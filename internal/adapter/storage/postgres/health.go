@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/jackc/pgx/v5"
+)
+
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_.+\.up\.sql$`)
+
+func latestMigrationVersion(dir string) (uint, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+
+	var latest uint
+	for _, entry := range entries {
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		latest = max(latest, uint(version))
+	}
+
+	return latest, nil
+}
+
+func (db *DB) Name() string {
+	return "postgres"
+}
+
+func (db *DB) Check(ctx context.Context) error {
+	return db.Ping(ctx)
+}
+
+type migrationChecker struct {
+	db *DB
+}
+
+// MigrationChecker verifica se a versão aplicada no banco é a mesma das
+// migrations empacotadas com esta versão da API.
+func (db *DB) MigrationChecker() port.HealthChecker {
+	return &migrationChecker{db}
+}
+
+func (mc *migrationChecker) Name() string {
+	return "migrations"
+}
+
+func (mc *migrationChecker) Check(ctx context.Context) error {
+	var version uint
+	var dirty bool
+
+	err := mc.db.QueryRow(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("nenhuma migration aplicada, esperado %d", mc.db.expectedVersion)
+	}
+	if err != nil {
+		return err
+	}
+
+	if dirty {
+		return fmt.Errorf("migration %d em estado inconsistente (dirty)", version)
+	}
+
+	if version != mc.db.expectedVersion {
+		return fmt.Errorf("versão %d aplicada, esperado %d", version, mc.db.expectedVersion)
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+ALTER TABLE "users" ADD COLUMN "failed_login_attempts" INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE "users" ADD COLUMN "locked_until" timestamptz;
+
+-- CreateTable
+CREATE TABLE "rate_limits" (
+    "key" TEXT PRIMARY KEY NOT NULL,
+    "tokens" DOUBLE PRECISION NOT NULL,
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "idx_rate_limits_updated_at" ON "rate_limits"("updated_at");
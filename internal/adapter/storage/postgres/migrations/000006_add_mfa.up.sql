@@ -0,0 +1,19 @@
+ALTER TABLE "users" ADD COLUMN "mfa_enabled" BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE "users" ADD COLUMN "totp_secret" varchar NOT NULL DEFAULT '';
+ALTER TABLE "users" ADD COLUMN "totp_last_step" BIGINT NOT NULL DEFAULT 0;
+
+-- CreateTable
+CREATE TABLE "user_recovery_codes" (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "code_hash" varchar NOT NULL,
+    "used_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+-- AddForeignKey
+ALTER TABLE "user_recovery_codes" ADD CONSTRAINT "user_recovery_codes_user_id_fkey"
+FOREIGN KEY ("user_id") REFERENCES "users"("id")
+ON DELETE CASCADE ON UPDATE CASCADE;
+
+CREATE INDEX "idx_user_recovery_codes_user_id" ON "user_recovery_codes"("user_id");
@@ -0,0 +1,26 @@
+ALTER TABLE "users" ADD COLUMN "email_verified_at" timestamptz;
+
+-- Contas existentes já estavam em uso sem verificação e são consideradas verificadas.
+UPDATE "users" SET "email_verified_at" = "created_at";
+
+-- CreateEnum
+CREATE TYPE "user_token_purpose_enum" AS ENUM ('password_reset', 'email_verification');
+
+-- CreateTable
+CREATE TABLE "user_tokens" (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "purpose" "user_token_purpose_enum" NOT NULL,
+    "token_hash" varchar NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "used_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "idx_user_tokens_token_hash" ON "user_tokens"("token_hash");
+CREATE INDEX "idx_user_tokens_user_id_purpose" ON "user_tokens"("user_id", "purpose");
+
+-- AddForeignKey
+ALTER TABLE "user_tokens" ADD CONSTRAINT "user_tokens_user_id_fkey"
+FOREIGN KEY ("user_id") REFERENCES "users"("id")
+ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "user_password_history";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "must_change_password";
@@ -0,0 +1,16 @@
+ALTER TABLE "users" ADD COLUMN "must_change_password" BOOLEAN NOT NULL DEFAULT false;
+
+-- CreateTable
+CREATE TABLE "user_password_history" (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "password_hash" varchar NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "idx_user_password_history_user_id_created_at" ON "user_password_history"("user_id", "created_at" DESC);
+
+-- AddForeignKey
+ALTER TABLE "user_password_history" ADD CONSTRAINT "user_password_history_user_id_fkey"
+FOREIGN KEY ("user_id") REFERENCES "users"("id")
+ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,21 @@
+-- CreateTable
+CREATE TABLE "api_keys" (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "name" varchar NOT NULL,
+    "prefix" varchar NOT NULL,
+    "key_hash" varchar NOT NULL,
+    "scopes" TEXT[] NOT NULL DEFAULT '{}',
+    "expires_at" timestamptz,
+    "last_used_at" timestamptz,
+    "revoked_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "idx_api_keys_key_hash" ON "api_keys"("key_hash");
+CREATE INDEX "idx_api_keys_user_id" ON "api_keys"("user_id");
+
+-- AddForeignKey
+ALTER TABLE "api_keys" ADD CONSTRAINT "api_keys_user_id_fkey"
+FOREIGN KEY ("user_id") REFERENCES "users"("id")
+ON DELETE CASCADE ON UPDATE CASCADE;
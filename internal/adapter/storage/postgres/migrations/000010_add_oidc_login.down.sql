@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "oidc_states";
+
+DROP INDEX IF EXISTS "idx_users_oidc_subject";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "oidc_subject";
@@ -0,0 +1,14 @@
+ALTER TABLE "users" ADD COLUMN "oidc_subject" varchar;
+
+CREATE UNIQUE INDEX "idx_users_oidc_subject" ON "users"("oidc_subject");
+
+-- CreateTable
+CREATE TABLE "oidc_states" (
+    "state_hash" varchar PRIMARY KEY NOT NULL,
+    "code_verifier" varchar NOT NULL,
+    "nonce" varchar NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "idx_oidc_states_expires_at" ON "oidc_states"("expires_at");
@@ -0,0 +1,21 @@
+-- Tokens emitidos antes desta migration não têm sessão e deixam de ser aceitos.
+
+-- CreateTable
+CREATE TABLE "sessions" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "user_id" uuid NOT NULL,
+    "ip" varchar NOT NULL DEFAULT '',
+    "user_agent" varchar NOT NULL DEFAULT '',
+    "mfa" boolean NOT NULL DEFAULT false,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "last_seen_at" timestamptz NOT NULL DEFAULT (now()),
+    "expires_at" timestamptz NOT NULL,
+    "revoked_at" timestamptz
+);
+
+CREATE INDEX "idx_sessions_user_id" ON "sessions"("user_id");
+
+-- AddForeignKey
+ALTER TABLE "sessions" ADD CONSTRAINT "sessions_user_id_fkey"
+FOREIGN KEY ("user_id") REFERENCES "users"("id")
+ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,3 @@
+ALTER TABLE "backup_plans" DROP COLUMN IF EXISTS "retention_policy_id";
+
+DROP TABLE IF EXISTS "retention_policies";
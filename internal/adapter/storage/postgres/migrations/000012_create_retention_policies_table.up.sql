@@ -0,0 +1,30 @@
+-- CreateTable
+CREATE TABLE "retention_policies" (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "customer_id" uuid NOT NULL,
+    "name" TEXT NOT NULL,
+    "keep_last" INTEGER NOT NULL DEFAULT 0 CHECK ("keep_last" >= 0),
+    "keep_daily" INTEGER NOT NULL DEFAULT 0 CHECK ("keep_daily" >= 0),
+    "keep_weekly" INTEGER NOT NULL DEFAULT 0 CHECK ("keep_weekly" >= 0),
+    "keep_monthly" INTEGER NOT NULL DEFAULT 0 CHECK ("keep_monthly" >= 0),
+    "keep_yearly" INTEGER NOT NULL DEFAULT 0 CHECK ("keep_yearly" >= 0),
+    "max_age_days" INTEGER NOT NULL DEFAULT 0 CHECK ("max_age_days" >= 0),
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "idx_retention_policies_customer_id_name" ON "retention_policies"("customer_id", "name");
+
+ALTER TABLE "backup_plans" ADD COLUMN "retention_policy_id" uuid;
+
+CREATE INDEX "idx_backup_plans_retention_policy_id" ON "backup_plans"("retention_policy_id");
+
+-- AddForeignKey
+ALTER TABLE "retention_policies" ADD CONSTRAINT "retention_policies_customer_id_fkey"
+FOREIGN KEY ("customer_id") REFERENCES "customers"("id")
+ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "backup_plans" ADD CONSTRAINT "backup_plans_retention_policy_id_fkey"
+FOREIGN KEY ("retention_policy_id") REFERENCES "retention_policies"("id")
+ON DELETE RESTRICT ON UPDATE CASCADE;
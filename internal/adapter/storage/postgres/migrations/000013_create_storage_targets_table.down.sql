@@ -0,0 +1,5 @@
+ALTER TABLE "backup_plans" DROP COLUMN IF EXISTS "storage_target_id";
+
+DROP TABLE IF EXISTS "storage_targets";
+
+DROP TYPE IF EXISTS "storage_target_kind_enum";
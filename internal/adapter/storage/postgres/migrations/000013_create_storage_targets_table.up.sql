@@ -0,0 +1,30 @@
+-- CreateEnum
+CREATE TYPE "storage_target_kind_enum" AS ENUM ('local', 'nas_smb', 's3', 'tape');
+
+-- CreateTable
+CREATE TABLE "storage_targets" (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "customer_id" uuid,
+    "name" TEXT NOT NULL,
+    "kind" "storage_target_kind_enum" NOT NULL,
+    "endpoint" TEXT NOT NULL,
+    "capacity_bytes" BIGINT NOT NULL CHECK ("capacity_bytes" > 0),
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "idx_storage_targets_customer_id" ON "storage_targets"("customer_id");
+
+ALTER TABLE "backup_plans" ADD COLUMN "storage_target_id" uuid;
+
+CREATE INDEX "idx_backup_plans_storage_target_id" ON "backup_plans"("storage_target_id");
+
+-- AddForeignKey
+ALTER TABLE "storage_targets" ADD CONSTRAINT "storage_targets_customer_id_fkey"
+FOREIGN KEY ("customer_id") REFERENCES "customers"("id")
+ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "backup_plans" ADD CONSTRAINT "backup_plans_storage_target_id_fkey"
+FOREIGN KEY ("storage_target_id") REFERENCES "storage_targets"("id")
+ON DELETE RESTRICT ON UPDATE CASCADE;
@@ -0,0 +1,3 @@
+ALTER TABLE "storage_targets" DROP COLUMN IF EXISTS "credentials_secret_id";
+
+DROP TABLE IF EXISTS "secrets";
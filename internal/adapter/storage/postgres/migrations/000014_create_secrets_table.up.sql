@@ -0,0 +1,18 @@
+-- CreateTable
+CREATE TABLE "secrets" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "key_id" TEXT NOT NULL,
+    "data_key" BYTEA NOT NULL,
+    "ciphertext" BYTEA NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "idx_secrets_key_id" ON "secrets"("key_id");
+
+ALTER TABLE "storage_targets" ADD COLUMN "credentials_secret_id" uuid;
+
+-- AddForeignKey
+ALTER TABLE "storage_targets" ADD CONSTRAINT "storage_targets_credentials_secret_id_fkey"
+FOREIGN KEY ("credentials_secret_id") REFERENCES "secrets"("id")
+ON DELETE RESTRICT ON UPDATE CASCADE;
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS "backup_plan_sources";
+
+ALTER TABLE "backup_plans" DROP COLUMN IF EXISTS "encryption";
+
+ALTER TABLE "backup_plans" DROP COLUMN IF EXISTS "compression";
+
+ALTER TABLE "backup_plans_week_days" DROP COLUMN IF EXISTS "backup_type";
+
+DROP TYPE IF EXISTS "backup_source_kind_enum";
+
+DROP TYPE IF EXISTS "backup_type_enum";
@@ -0,0 +1,30 @@
+-- CreateEnum
+CREATE TYPE "backup_type_enum" AS ENUM ('full', 'incremental', 'differential');
+
+-- CreateEnum
+CREATE TYPE "backup_source_kind_enum" AS ENUM ('path', 'volume', 'database');
+
+ALTER TABLE "backup_plans_week_days" ADD COLUMN "backup_type" "backup_type_enum" NOT NULL DEFAULT 'full';
+
+ALTER TABLE "backup_plans" ADD COLUMN "compression" BOOLEAN NOT NULL DEFAULT true;
+
+ALTER TABLE "backup_plans" ADD COLUMN "encryption" BOOLEAN NOT NULL DEFAULT false;
+
+-- CreateTable
+CREATE TABLE "backup_plan_sources" (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "backup_plan_id" uuid NOT NULL,
+    "kind" "backup_source_kind_enum" NOT NULL,
+    "location" TEXT NOT NULL,
+    "include" TEXT[] NOT NULL DEFAULT '{}',
+    "exclude" TEXT[] NOT NULL DEFAULT '{}',
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "idx_backup_plan_sources_backup_plan_id" ON "backup_plan_sources"("backup_plan_id");
+
+-- AddForeignKey
+ALTER TABLE "backup_plan_sources" ADD CONSTRAINT "backup_plan_sources_backup_plan_id_fkey"
+FOREIGN KEY ("backup_plan_id") REFERENCES "backup_plans"("id")
+ON DELETE RESTRICT ON UPDATE CASCADE;
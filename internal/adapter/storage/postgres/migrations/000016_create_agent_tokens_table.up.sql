@@ -0,0 +1,18 @@
+-- CreateTable
+CREATE TABLE "agent_tokens" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "device_id" uuid NOT NULL,
+    "prefix" TEXT NOT NULL,
+    "token_hash" TEXT NOT NULL,
+    "last_used_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "idx_agent_tokens_device_id" ON "agent_tokens"("device_id");
+
+CREATE UNIQUE INDEX "idx_agent_tokens_token_hash" ON "agent_tokens"("token_hash");
+
+-- AddForeignKey
+ALTER TABLE "agent_tokens" ADD CONSTRAINT "agent_tokens_device_id_fkey"
+FOREIGN KEY ("device_id") REFERENCES "devices"("id")
+ON DELETE CASCADE ON UPDATE CASCADE;
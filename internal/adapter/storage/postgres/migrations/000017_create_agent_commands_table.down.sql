@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "agent_commands";
+
+DROP TYPE IF EXISTS "agent_command_status_enum";
+
+DROP TYPE IF EXISTS "agent_command_type_enum";
@@ -0,0 +1,39 @@
+-- CreateEnum
+CREATE TYPE "agent_command_type_enum" AS ENUM ('run_backup', 'verify', 'restore_test', 'update_agent');
+
+-- CreateEnum
+CREATE TYPE "agent_command_status_enum" AS ENUM ('pending', 'acknowledged', 'succeeded', 'failed', 'expired');
+
+-- CreateTable
+CREATE TABLE "agent_commands" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "device_id" uuid NOT NULL,
+    "type" "agent_command_type_enum" NOT NULL,
+    "backup_plan_id" uuid,
+    "agent_version" TEXT NOT NULL DEFAULT '',
+    "status" "agent_command_status_enum" NOT NULL DEFAULT 'pending',
+    "result" TEXT NOT NULL DEFAULT '',
+    "requested_by" uuid,
+    "expires_at" timestamptz NOT NULL,
+    "acknowledged_at" timestamptz,
+    "completed_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "idx_agent_commands_device_id_status" ON "agent_commands"("device_id", "status");
+
+-- AddForeignKey
+ALTER TABLE "agent_commands" ADD CONSTRAINT "agent_commands_device_id_fkey"
+FOREIGN KEY ("device_id") REFERENCES "devices"("id")
+ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "agent_commands" ADD CONSTRAINT "agent_commands_backup_plan_id_fkey"
+FOREIGN KEY ("backup_plan_id") REFERENCES "backup_plans"("id")
+ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "agent_commands" ADD CONSTRAINT "agent_commands_requested_by_fkey"
+FOREIGN KEY ("requested_by") REFERENCES "users"("id")
+ON DELETE SET NULL ON UPDATE CASCADE;
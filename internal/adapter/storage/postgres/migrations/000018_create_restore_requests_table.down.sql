@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "restore_requests";
+
+DROP TYPE IF EXISTS "restore_request_status_enum";
@@ -0,0 +1,46 @@
+-- CreateEnum
+CREATE TYPE "restore_request_status_enum" AS ENUM ('requested', 'approved', 'rejected', 'in_progress', 'completed', 'failed');
+
+-- CreateTable
+CREATE TABLE "restore_requests" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "backup_plan_id" uuid NOT NULL,
+    "restore_point_id" uuid NOT NULL,
+    "target_device_id" uuid NOT NULL,
+    "target_path" TEXT NOT NULL DEFAULT '',
+    "reason" TEXT NOT NULL,
+    "status" "restore_request_status_enum" NOT NULL DEFAULT 'requested',
+    "requested_by" uuid,
+    "reviewed_by" uuid,
+    "review_note" TEXT NOT NULL DEFAULT '',
+    "reviewed_at" timestamptz,
+    "started_at" timestamptz,
+    "completed_at" timestamptz,
+    "result" TEXT NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "idx_restore_requests_status" ON "restore_requests"("status");
+
+CREATE INDEX "idx_restore_requests_target_device_id_status" ON "restore_requests"("target_device_id", "status");
+
+-- AddForeignKey
+ALTER TABLE "restore_requests" ADD CONSTRAINT "restore_requests_backup_plan_id_fkey"
+FOREIGN KEY ("backup_plan_id") REFERENCES "backup_plans"("id")
+ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "restore_requests" ADD CONSTRAINT "restore_requests_target_device_id_fkey"
+FOREIGN KEY ("target_device_id") REFERENCES "devices"("id")
+ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "restore_requests" ADD CONSTRAINT "restore_requests_requested_by_fkey"
+FOREIGN KEY ("requested_by") REFERENCES "users"("id")
+ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "restore_requests" ADD CONSTRAINT "restore_requests_reviewed_by_fkey"
+FOREIGN KEY ("reviewed_by") REFERENCES "users"("id")
+ON DELETE SET NULL ON UPDATE CASCADE;
@@ -0,0 +1,19 @@
+-- CreateTable
+CREATE TABLE "restore_points" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "backup_plan_id" uuid NOT NULL,
+    "type" "backup_type_enum" NOT NULL,
+    "size_bytes" BIGINT NOT NULL,
+    "checksum" TEXT NOT NULL DEFAULT '',
+    "location" TEXT NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "expires_at" timestamptz,
+    "reported_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "idx_restore_points_backup_plan_id_created_at" ON "restore_points"("backup_plan_id", "created_at");
+
+-- AddForeignKey
+ALTER TABLE "restore_points" ADD CONSTRAINT "restore_points_backup_plan_id_fkey"
+FOREIGN KEY ("backup_plan_id") REFERENCES "backup_plans"("id")
+ON DELETE RESTRICT ON UPDATE CASCADE;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "verification_jobs";
+DROP TABLE IF EXISTS "verification_schedules";
+DROP TYPE IF EXISTS "verification_job_status_enum";
+DROP TYPE IF EXISTS "verification_kind_enum";
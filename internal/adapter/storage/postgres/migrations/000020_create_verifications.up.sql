@@ -0,0 +1,48 @@
+-- CreateEnum
+CREATE TYPE "verification_kind_enum" AS ENUM ('verify', 'restore_test');
+
+-- CreateEnum
+CREATE TYPE "verification_job_status_enum" AS ENUM ('pending', 'succeeded', 'failed');
+
+-- CreateTable
+CREATE TABLE "verification_schedules" (
+    "backup_plan_id" uuid PRIMARY KEY NOT NULL,
+    "kind" "verification_kind_enum" NOT NULL DEFAULT 'verify',
+    "interval_days" INTEGER NOT NULL,
+    "grace_days" INTEGER NOT NULL DEFAULT 0,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+-- CreateTable
+CREATE TABLE "verification_jobs" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "backup_plan_id" uuid NOT NULL,
+    "kind" "verification_kind_enum" NOT NULL,
+    "status" "verification_job_status_enum" NOT NULL DEFAULT 'pending',
+    "scheduled_at" timestamptz NOT NULL,
+    "restore_point_id" uuid,
+    "result" TEXT NOT NULL DEFAULT '',
+    "completed_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "idx_verification_jobs_backup_plan_id_created_at" ON "verification_jobs"("backup_plan_id", "created_at");
+
+CREATE UNIQUE INDEX "verification_jobs_pending_backup_plan_id_key" ON "verification_jobs"("backup_plan_id") WHERE "status" = 'pending';
+
+-- AddForeignKey
+ALTER TABLE "verification_schedules" ADD CONSTRAINT "verification_schedules_backup_plan_id_fkey"
+FOREIGN KEY ("backup_plan_id") REFERENCES "backup_plans"("id")
+ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "verification_jobs" ADD CONSTRAINT "verification_jobs_backup_plan_id_fkey"
+FOREIGN KEY ("backup_plan_id") REFERENCES "backup_plans"("id")
+ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "verification_jobs" ADD CONSTRAINT "verification_jobs_restore_point_id_fkey"
+FOREIGN KEY ("restore_point_id") REFERENCES "restore_points"("id")
+ON DELETE SET NULL ON UPDATE CASCADE;
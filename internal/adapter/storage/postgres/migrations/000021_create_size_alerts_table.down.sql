@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "size_alerts";
+
+DROP TYPE IF EXISTS "size_alert_severity_enum";
+DROP TYPE IF EXISTS "size_anomaly_kind_enum";
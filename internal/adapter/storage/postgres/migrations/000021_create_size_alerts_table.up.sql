@@ -0,0 +1,34 @@
+-- CreateEnum
+CREATE TYPE "size_anomaly_kind_enum" AS ENUM ('growth', 'shrinkage', 'zero_bytes');
+
+-- CreateEnum
+CREATE TYPE "size_alert_severity_enum" AS ENUM ('warning', 'critical');
+
+-- CreateTable
+CREATE TABLE "size_alerts" (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "backup_plan_id" uuid NOT NULL,
+    "restore_point_id" uuid,
+    "type" "backup_type_enum" NOT NULL,
+    "kind" "size_anomaly_kind_enum" NOT NULL,
+    "severity" "size_alert_severity_enum" NOT NULL,
+    "size_bytes" BIGINT NOT NULL,
+    "baseline_median" BIGINT NOT NULL DEFAULT 0,
+    "baseline_mad" BIGINT NOT NULL DEFAULT 0,
+    "point_created_at" timestamptz NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "size_alerts_restore_point_id_key" ON "size_alerts"("restore_point_id");
+
+CREATE INDEX "idx_size_alerts_created_at" ON "size_alerts"("created_at");
+
+-- AddForeignKey
+ALTER TABLE "size_alerts" ADD CONSTRAINT "size_alerts_backup_plan_id_fkey"
+FOREIGN KEY ("backup_plan_id") REFERENCES "backup_plans"("id")
+ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "size_alerts" ADD CONSTRAINT "size_alerts_restore_point_id_fkey"
+FOREIGN KEY ("restore_point_id") REFERENCES "restore_points"("id")
+ON DELETE SET NULL ON UPDATE CASCADE;
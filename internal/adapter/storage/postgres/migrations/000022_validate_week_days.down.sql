@@ -0,0 +1 @@
+ALTER TABLE "backup_plans_week_days" DROP CONSTRAINT IF EXISTS "backup_plans_week_days_day_check";
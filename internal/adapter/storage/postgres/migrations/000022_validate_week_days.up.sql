@@ -0,0 +1,15 @@
+-- O dia é comparado com time.Weekday.String() no agendamento; o enum precisa
+-- continuar com essa grafia, sem rótulos a mais ou a menos.
+DO $$
+BEGIN
+    IF (SELECT array_agg("enumlabel"::TEXT ORDER BY "enumsortorder")
+        FROM "pg_enum"
+        WHERE "enumtypid" = '"week_days_enum"'::regtype)
+        IS DISTINCT FROM ARRAY['Sunday', 'Monday', 'Tuesday', 'Wednesday', 'Thursday', 'Friday', 'Saturday'] THEN
+        RAISE EXCEPTION 'week_days_enum não corresponde aos nomes de time.Weekday';
+    END IF;
+END $$;
+
+-- AddCheck
+ALTER TABLE "backup_plans_week_days" ADD CONSTRAINT "backup_plans_week_days_day_check"
+CHECK ("day"::TEXT IN ('Sunday', 'Monday', 'Tuesday', 'Wednesday', 'Thursday', 'Friday', 'Saturday'));
@@ -0,0 +1 @@
+ALTER TABLE "oidc_states" DROP COLUMN IF EXISTS "user_id";
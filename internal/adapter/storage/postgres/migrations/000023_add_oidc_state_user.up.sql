@@ -0,0 +1,7 @@
+-- AlterTable
+ALTER TABLE "oidc_states" ADD COLUMN "user_id" uuid;
+
+-- AddForeignKey
+ALTER TABLE "oidc_states" ADD CONSTRAINT "oidc_states_user_id_fkey"
+FOREIGN KEY ("user_id") REFERENCES "users"("id")
+ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const agentCommandColumns = `id, device_id, type, backup_plan_id, agent_version, status, result, requested_by, expires_at, acknowledged_at, completed_at, created_at, updated_at`
+
+type agentCommandRepository struct {
+	db *postgres.DB
+}
+
+func NewAgentCommandRepository(db *postgres.DB) *agentCommandRepository {
+	return &agentCommandRepository{
+		db,
+	}
+}
+
+func scanAgentCommand(row pgx.Row, command *domain.AgentCommand) error {
+	return row.Scan(
+		&command.ID,
+		&command.DeviceID,
+		&command.Type,
+		&command.BackupPlanID,
+		&command.AgentVersion,
+		&command.Status,
+		&command.Result,
+		&command.RequestedBy,
+		&command.ExpiresAt,
+		&command.AcknowledgedAt,
+		&command.CompletedAt,
+		&command.CreatedAt,
+		&command.UpdatedAt,
+	)
+}
+
+func (acr *agentCommandRepository) CreateAgentCommand(ctx context.Context, command *domain.AgentCommand) error {
+	query := `
+		INSERT INTO agent_commands (id, device_id, type, backup_plan_id, agent_version, status, requested_by, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, now(), now())
+		RETURNING created_at, updated_at
+	`
+	err := acr.db.QueryRow(ctx, query,
+		command.ID,
+		command.DeviceID,
+		command.Type,
+		command.BackupPlanID,
+		command.AgentVersion,
+		command.Status,
+		command.RequestedBy,
+		command.ExpiresAt,
+	).Scan(&command.CreatedAt, &command.UpdatedAt)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir comando do agente", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (acr *agentCommandRepository) GetAgentCommandByID(ctx context.Context, id uuid.UUID) (*domain.AgentCommand, error) {
+	var command domain.AgentCommand
+	query := `SELECT ` + agentCommandColumns + ` FROM agent_commands WHERE id = $1`
+
+	err := scanAgentCommand(acr.db.QueryRow(ctx, query, id), &command)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar comando do agente", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return &command, nil
+}
+
+func (acr *agentCommandRepository) ListAgentCommands(ctx context.Context, deviceID uuid.UUID, page, limit int) ([]domain.AgentCommand, error) {
+	offset := (page - 1) * limit
+	query := `
+		SELECT ` + agentCommandColumns + `
+		FROM agent_commands
+		WHERE device_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	return acr.listAgentCommands(ctx, query, deviceID, limit, offset)
+}
+
+// ListPendingAgentCommands devolve os comandos ainda não expirados, do mais
+// antigo para o mais novo, que é a ordem de execução no agente.
+func (acr *agentCommandRepository) ListPendingAgentCommands(ctx context.Context, deviceID uuid.UUID) ([]domain.AgentCommand, error) {
+	query := `
+		SELECT ` + agentCommandColumns + `
+		FROM agent_commands
+		WHERE device_id = $1 AND status = 'pending' AND expires_at > now()
+		ORDER BY created_at
+	`
+
+	return acr.listAgentCommands(ctx, query, deviceID)
+}
+
+func (acr *agentCommandRepository) listAgentCommands(ctx context.Context, query string, args ...any) ([]domain.AgentCommand, error) {
+	rows, err := acr.db.Query(ctx, query, args...)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao listar comandos do agente", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	commands := []domain.AgentCommand{}
+	for rows.Next() {
+		var command domain.AgentCommand
+		if err := scanAgentCommand(rows, &command); err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler comando do agente", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+		commands = append(commands, command)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao percorrer comandos do agente", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return commands, nil
+}
+
+func (acr *agentCommandRepository) UpdateAgentCommand(ctx context.Context, command *domain.AgentCommand, from domain.AgentCommandStatus) error {
+	query := `
+		UPDATE agent_commands
+		SET status = $1, result = $2, acknowledged_at = $3, completed_at = $4, updated_at = now()
+		WHERE id = $5 AND status = $6
+		RETURNING updated_at
+	`
+	err := acr.db.QueryRow(ctx, query,
+		command.Status,
+		command.Result,
+		command.AcknowledgedAt,
+		command.CompletedAt,
+		command.ID,
+		from,
+	).Scan(&command.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrAgentCommandState
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar comando do agente", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+// ExpireAgentCommands marca como expirados os comandos pendentes vencidos do
+// dispositivo. A expiração é feita sob demanda, quando os comandos são
+// consultados pela administração; ListPendingAgentCommands já ignora os
+// vencidos.
+func (acr *agentCommandRepository) ExpireAgentCommands(ctx context.Context, deviceID uuid.UUID) error {
+	query := `
+		UPDATE agent_commands
+		SET status = 'expired', updated_at = now()
+		WHERE device_id = $1 AND status = 'pending' AND expires_at <= now()
+	`
+	_, err := acr.db.Exec(ctx, query, deviceID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao expirar comandos do agente", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+// CountAgentCommandsByStatus conta como expirados os pendentes já vencidos,
+// que só mudam de status quando o agente volta a buscar comandos.
+func (acr *agentCommandRepository) CountAgentCommandsByStatus(ctx context.Context) (map[domain.AgentCommandStatus]int, error) {
+	query := `
+		SELECT CASE WHEN status = 'pending' AND expires_at <= now() THEN 'expired' ELSE status::TEXT END AS current_status, COUNT(*)
+		FROM agent_commands
+		GROUP BY current_status
+	`
+	return countByStatus[domain.AgentCommandStatus](ctx, acr.db, query, "comandos")
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type agentTokenRepository struct {
+	db *postgres.DB
+}
+
+func NewAgentTokenRepository(db *postgres.DB) *agentTokenRepository {
+	return &agentTokenRepository{
+		db,
+	}
+}
+
+func (atr *agentTokenRepository) SaveAgentToken(ctx context.Context, token *domain.AgentToken) error {
+	query := `
+		INSERT INTO agent_tokens (id, device_id, prefix, token_hash, created_at)
+		VALUES ($1, $2, $3, $4, now())
+		ON CONFLICT (device_id) DO UPDATE
+		SET id = EXCLUDED.id, prefix = EXCLUDED.prefix, token_hash = EXCLUDED.token_hash, last_used_at = NULL, created_at = now()
+		RETURNING created_at
+	`
+	err := atr.db.QueryRow(ctx, query, token.ID, token.DeviceID, token.Prefix, token.TokenHash).Scan(&token.CreatedAt)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao gravar token do agente", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (atr *agentTokenRepository) GetAgentTokenByHash(ctx context.Context, tokenHash string) (*domain.AgentToken, error) {
+	var token domain.AgentToken
+	query := `
+		SELECT id, device_id, prefix, token_hash, last_used_at, created_at
+		FROM agent_tokens
+		WHERE token_hash = $1
+	`
+
+	err := atr.db.QueryRow(ctx, query, tokenHash).Scan(
+		&token.ID,
+		&token.DeviceID,
+		&token.Prefix,
+		&token.TokenHash,
+		&token.LastUsedAt,
+		&token.CreatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar token do agente", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return &token, nil
+}
+
+// TouchAgentToken atualiza o último uso com resolução de um minuto, já que o
+// agente consulta a API periodicamente.
+func (atr *agentTokenRepository) TouchAgentToken(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE agent_tokens
+		SET last_used_at = now()
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < now() - interval '1 minute')
+	`
+	_, err := atr.db.Exec(ctx, query, id)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar último uso do token do agente", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (atr *agentTokenRepository) DeleteAgentToken(ctx context.Context, deviceID uuid.UUID) error {
+	result, err := atr.db.Exec(ctx, `DELETE FROM agent_tokens WHERE device_id = $1`, deviceID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao excluir token do agente", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
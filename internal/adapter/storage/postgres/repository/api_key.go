@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const apiKeyColumns = `id, user_id, name, prefix, key_hash, scopes, expires_at, last_used_at, revoked_at, created_at`
+
+type apiKeyRepository struct {
+	db *postgres.DB
+}
+
+func NewAPIKeyRepository(db *postgres.DB) *apiKeyRepository {
+	return &apiKeyRepository{
+		db,
+	}
+}
+
+func scanAPIKey(row pgx.Row, key *domain.APIKey) error {
+	return row.Scan(
+		&key.ID,
+		&key.UserID,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		&key.Scopes,
+		&key.ExpiresAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+		&key.CreatedAt,
+	)
+}
+
+func (akr *apiKeyRepository) CreateAPIKey(ctx context.Context, key *domain.APIKey) error {
+	query := `
+		INSERT INTO api_keys (id, user_id, name, prefix, key_hash, scopes, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, now())
+		RETURNING created_at
+	`
+	err := akr.db.QueryRow(ctx, query, key.ID, key.UserID, key.Name, key.Prefix, key.KeyHash, key.Scopes, key.ExpiresAt).Scan(&key.CreatedAt)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir chave de API", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (akr *apiKeyRepository) GetAPIKeyByID(ctx context.Context, id uuid.UUID) (*domain.APIKey, error) {
+	var key domain.APIKey
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE id = $1`
+
+	err := scanAPIKey(akr.db.QueryRow(ctx, query, id), &key)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar chave de API", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return &key, nil
+}
+
+func (akr *apiKeyRepository) GetAPIKeyByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	var key domain.APIKey
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE key_hash = $1`
+
+	err := scanAPIKey(akr.db.QueryRow(ctx, query, keyHash), &key)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar chave de API", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return &key, nil
+}
+
+func (akr *apiKeyRepository) ListAPIKeysByUser(ctx context.Context, userID uuid.UUID) ([]domain.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE user_id = $1 ORDER BY created_at DESC`
+	return akr.list(ctx, query, userID)
+}
+
+func (akr *apiKeyRepository) ListAPIKeys(ctx context.Context, page, limit int) ([]domain.APIKey, error) {
+	offset := (page - 1) * limit
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys ORDER BY created_at DESC LIMIT $1 OFFSET $2`
+	return akr.list(ctx, query, limit, offset)
+}
+
+func (akr *apiKeyRepository) list(ctx context.Context, query string, args ...any) ([]domain.APIKey, error) {
+	rows, err := akr.db.Query(ctx, query, args...)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao listar chaves de API", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	var keys []domain.APIKey
+	for rows.Next() {
+		var key domain.APIKey
+		if err := scanAPIKey(rows, &key); err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler chave de API", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao percorrer chaves de API", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return keys, nil
+}
+
+func (akr *apiKeyRepository) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE api_keys
+		SET revoked_at = COALESCE(revoked_at, now())
+		WHERE id = $1
+	`
+	result, err := akr.db.Exec(ctx, query, id)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao revogar chave de API", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+// TouchAPIKey registra o último uso com resolução de um minuto, para não
+// gerar uma escrita a cada requisição de scripts que usam a chave em laço.
+func (akr *apiKeyRepository) TouchAPIKey(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE api_keys
+		SET last_used_at = now()
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < now() - interval '1 minute')
+	`
+	_, err := akr.db.Exec(ctx, query, id)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar uso da chave de API", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
@@ -2,13 +2,16 @@ package repository
 
 import (
 	"context"
-	"log/slog"
+	"errors"
 	"math/big"
 	"time"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type backupPlanRepository struct {
@@ -21,54 +24,63 @@ func NewBackupPlanRepository(db *postgres.DB) *backupPlanRepository {
 	}
 }
 
-func (bpr *backupPlanRepository) CreateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan) error {
+func (bpr *backupPlanRepository) CreateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan, check port.StorageCapacityCheck) error {
 	now := time.Now()
 
 	tx, err := bpr.db.Begin(ctx)
 	if err != nil {
-		slog.Error("Erro ao iniciar transação", "error", err.Error())
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
 	}
 	defer tx.Rollback(ctx)
 
+	if err := checkStorageCapacity(ctx, tx, backupPlan, check); err != nil {
+		return err
+	}
+
 	queryPlan := `
-		INSERT INTO backup_plans (id, name, backup_size_bytes, device_id, created_at, updated_at)
-    VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO backup_plans (id, name, backup_size_bytes, device_id, retention_policy_id, storage_target_id, compression, encryption, created_at, updated_at)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
-	result, err := tx.Exec(ctx, queryPlan, backupPlan.ID, backupPlan.Name, backupPlan.BackupSizeBytes, backupPlan.DeviceID, now, now)
+	result, err := tx.Exec(ctx, queryPlan, backupPlan.ID, backupPlan.Name, backupPlan.BackupSizeBytes, backupPlan.DeviceID, backupPlan.RetentionPolicyID, backupPlan.StorageTargetID, backupPlan.Compression, backupPlan.Encryption, now, now)
 	if err != nil {
-		slog.Error("Erro ao inserir na tabela plano de backup", "error", err)
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir na tabela plano de backup", "error", err)
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
-		slog.Error("Nenhuma linha foi afetada ao incluir o plano de backup")
-		return err
+		utils.Logger(ctx).ErrorContext(ctx, "Nenhuma linha foi afetada ao incluir o plano de backup")
+		return domain.ErrInternal
 	}
 
 	queryWeek := `
-			INSERT INTO backup_plans_week_days (id, day, time_day, backup_plan_id, created_at, updated_at)
-      VALUES ($1, $2, $3, $4, $5, $6)
+			INSERT INTO backup_plans_week_days (id, day, time_day, backup_type, backup_plan_id, created_at, updated_at)
+      VALUES ($1, $2, $3, $4, $5, $6, $7)
 		`
 	for _, day := range backupPlan.WeekDays {
 		day.BackupPlanID = backupPlan.ID
 
-		result, err := tx.Exec(ctx, queryWeek, day.ID, day.Day, day.TimeDay, day.BackupPlanID, now, now)
+		result, err := tx.Exec(ctx, queryWeek, day.ID, day.Day, day.TimeDay, day.BackupType, day.BackupPlanID, now, now)
 		if err != nil {
-			slog.Error("Erro ao inserir na tabela plano de backup dias de semana", "error", err)
-			return handlePgDatabaseError(err)
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir na tabela plano de backup dias de semana", "error", err)
+			return handlePgDatabaseError(ctx, err)
 		}
 
 		if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
-			slog.Error("Nenhuma linha foi afetada ao incluir os dias de semana do plano de backup")
-			return err
+			utils.Logger(ctx).ErrorContext(ctx, "Nenhuma linha foi afetada ao incluir os dias de semana do plano de backup")
+			return domain.ErrInternal
 		}
 	}
 
+	err = insertBackupPlanSources(ctx, tx, backupPlan.ID, backupPlan.Sources, now)
+	if err != nil {
+		return err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
-		slog.Error("Erro ao fazer commit", "error", err)
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao fazer commit", "error", err)
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	return nil
@@ -83,11 +95,16 @@ func (bpr *backupPlanRepository) GetBackupPlanByID(ctx context.Context, id uuid.
                bp.name, 
                bp.backup_size_bytes, 
                bp.device_id, 
+               bp.retention_policy_id,
+               bp.storage_target_id,
+               bp.compression,
+               bp.encryption,
                bp.created_at, 
                bp.updated_at,
                wd.id,
                wd.day,
                wd.time_day,
+               wd.backup_type,
                wd.backup_plan_id,
                wd.created_at,
                wd.updated_at
@@ -98,8 +115,8 @@ func (bpr *backupPlanRepository) GetBackupPlanByID(ctx context.Context, id uuid.
 
 	rows, err := bpr.db.Query(ctx, query, id)
 	if err != nil {
-		slog.Error("Erro ao buscar plano de backup pelo id", "error", err.Error())
-		return nil, handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar plano de backup pelo id", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
 	}
 	defer rows.Close()
 
@@ -113,30 +130,39 @@ func (bpr *backupPlanRepository) GetBackupPlanByID(ctx context.Context, id uuid.
 			&bp.Name,
 			&backupSizeBytes,
 			&bp.DeviceID,
+			&bp.RetentionPolicyID,
+			&bp.StorageTargetID,
+			&bp.Compression,
+			&bp.Encryption,
 			&bp.CreatedAt,
 			&bp.UpdatedAt,
 			&wd.ID,
 			&wd.Day,
 			&wd.TimeDay,
+			&wd.BackupType,
 			&wd.BackupPlanID,
 			&wd.CreatedAt,
 			&wd.UpdatedAt,
 		)
 		if err != nil {
-			slog.Error("Erro ao buscar plano de backup pelo id", "error", err.Error())
-			return nil, handlePgDatabaseError(err)
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar plano de backup pelo id", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
 		}
 		bp.BackupSizeBytes = big.NewInt(backupSizeBytes)
 
 		if backupPlan == nil {
 			backupPlan = &domain.BackupPlan{
-				ID:              bp.ID,
-				Name:            bp.Name,
-				BackupSizeBytes: bp.BackupSizeBytes,
-				DeviceID:        bp.DeviceID,
-				CreatedAt:       bp.CreatedAt,
-				UpdatedAt:       bp.UpdatedAt,
-				WeekDays:        []domain.BackupPlanWeekDay{},
+				ID:                bp.ID,
+				Name:              bp.Name,
+				BackupSizeBytes:   bp.BackupSizeBytes,
+				DeviceID:          bp.DeviceID,
+				RetentionPolicyID: bp.RetentionPolicyID,
+				StorageTargetID:   bp.StorageTargetID,
+				Compression:       bp.Compression,
+				Encryption:        bp.Encryption,
+				CreatedAt:         bp.CreatedAt,
+				UpdatedAt:         bp.UpdatedAt,
+				WeekDays:          []domain.BackupPlanWeekDay{},
 			}
 		}
 
@@ -144,7 +170,7 @@ func (bpr *backupPlanRepository) GetBackupPlanByID(ctx context.Context, id uuid.
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, handlePgDatabaseError(err)
+		return nil, handlePgDatabaseError(ctx, err)
 	}
 
 	if backupPlan == nil {
@@ -152,6 +178,13 @@ func (bpr *backupPlanRepository) GetBackupPlanByID(ctx context.Context, id uuid.
 	}
 
 	backupPlan.WeekDays = weekDays
+
+	sources, err := bpr.listBackupPlanSources(ctx, []uuid.UUID{backupPlan.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	backupPlan.Sources = sources[backupPlan.ID]
 	return backupPlan, nil
 }
 
@@ -163,11 +196,16 @@ func (bpr *backupPlanRepository) ListBackupPlans(ctx context.Context, page, limi
                bp.name, 
                bp.backup_size_bytes, 
                bp.device_id, 
+               bp.retention_policy_id,
+               bp.storage_target_id,
+               bp.compression,
+               bp.encryption,
                bp.created_at, 
                bp.updated_at,
                wd.id,
                wd.day,
                wd.time_day,
+               wd.backup_type,
                wd.backup_plan_id,
                wd.created_at,
                wd.updated_at
@@ -179,7 +217,7 @@ func (bpr *backupPlanRepository) ListBackupPlans(ctx context.Context, page, limi
 
 	rows, err := bpr.db.Query(ctx, query, limit, offset)
 	if err != nil {
-		return nil, handlePgDatabaseError(err)
+		return nil, handlePgDatabaseError(ctx, err)
 	}
 	defer rows.Close()
 
@@ -193,17 +231,22 @@ func (bpr *backupPlanRepository) ListBackupPlans(ctx context.Context, page, limi
 			&bp.Name,
 			&backupSizeBytes,
 			&bp.DeviceID,
+			&bp.RetentionPolicyID,
+			&bp.StorageTargetID,
+			&bp.Compression,
+			&bp.Encryption,
 			&bp.CreatedAt,
 			&bp.UpdatedAt,
 			&wd.ID,
 			&wd.Day,
 			&wd.TimeDay,
+			&wd.BackupType,
 			&wd.BackupPlanID,
 			&wd.CreatedAt,
 			&wd.UpdatedAt,
 		)
 		if err != nil {
-			return nil, handlePgDatabaseError(err)
+			return nil, handlePgDatabaseError(ctx, err)
 		}
 
 		bp.BackupSizeBytes = big.NewInt(backupSizeBytes)
@@ -217,98 +260,363 @@ func (bpr *backupPlanRepository) ListBackupPlans(ctx context.Context, page, limi
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, handlePgDatabaseError(err)
+		return nil, handlePgDatabaseError(ctx, err)
 	}
 
 	if len(backupPlansMap) == 0 {
 		return nil, domain.ErrDataNotFound
 	}
 
+	ids := make([]uuid.UUID, 0, len(backupPlansMap))
+	for id := range backupPlansMap {
+		ids = append(ids, id)
+	}
+
+	sources, err := bpr.listBackupPlanSources(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
 	backupPlans := make([]domain.BackupPlan, 0, len(backupPlansMap))
 	for _, bp := range backupPlansMap {
+		bp.Sources = sources[bp.ID]
 		backupPlans = append(backupPlans, *bp)
 	}
 
 	return backupPlans, nil
 }
 
-func (bpr *backupPlanRepository) UpdateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan) error {
+// ListBackupPlansByDevice devolve todos os planos do dispositivo em ordem
+// estável, já que o agente compara a configuração entre consultas.
+func (bpr *backupPlanRepository) ListBackupPlansByDevice(ctx context.Context, deviceID uuid.UUID) ([]domain.BackupPlan, error) {
+	query := `
+        SELECT bp.id, 
+               bp.name, 
+               bp.backup_size_bytes, 
+               bp.device_id, 
+               bp.retention_policy_id,
+               bp.storage_target_id,
+               bp.compression,
+               bp.encryption,
+               bp.created_at, 
+               bp.updated_at,
+               wd.id,
+               wd.day,
+               wd.time_day,
+               wd.backup_type,
+               wd.backup_plan_id,
+               wd.created_at,
+               wd.updated_at
+        FROM backup_plans bp
+            INNER JOIN backup_plans_week_days wd ON (bp.id = wd.backup_plan_id)
+        WHERE bp.device_id = $1
+        ORDER BY bp.name, bp.id, wd.day, wd.time_day, wd.id
+    `
+
+	rows, err := bpr.db.Query(ctx, query, deviceID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao listar planos de backup do dispositivo", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	var backupPlans []domain.BackupPlan
+	for rows.Next() {
+		var bp domain.BackupPlan
+		var wd domain.BackupPlanWeekDay
+		var backupSizeBytes int64
+
+		err := rows.Scan(
+			&bp.ID,
+			&bp.Name,
+			&backupSizeBytes,
+			&bp.DeviceID,
+			&bp.RetentionPolicyID,
+			&bp.StorageTargetID,
+			&bp.Compression,
+			&bp.Encryption,
+			&bp.CreatedAt,
+			&bp.UpdatedAt,
+			&wd.ID,
+			&wd.Day,
+			&wd.TimeDay,
+			&wd.BackupType,
+			&wd.BackupPlanID,
+			&wd.CreatedAt,
+			&wd.UpdatedAt,
+		)
+		if err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler plano de backup do dispositivo", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+
+		if last := len(backupPlans) - 1; last >= 0 && backupPlans[last].ID == bp.ID {
+			backupPlans[last].WeekDays = append(backupPlans[last].WeekDays, wd)
+			continue
+		}
+
+		bp.BackupSizeBytes = big.NewInt(backupSizeBytes)
+		bp.WeekDays = []domain.BackupPlanWeekDay{wd}
+		backupPlans = append(backupPlans, bp)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	ids := make([]uuid.UUID, len(backupPlans))
+	for i, bp := range backupPlans {
+		ids[i] = bp.ID
+	}
+
+	sources, err := bpr.listBackupPlanSources(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range backupPlans {
+		backupPlans[i].Sources = sources[backupPlans[i].ID]
+	}
+
+	return backupPlans, nil
+}
+
+func (bpr *backupPlanRepository) CountBackupPlans(ctx context.Context) (int, error) {
+	var count int
+	err := bpr.db.QueryRow(ctx, `SELECT COUNT(*) FROM backup_plans`).Scan(&count)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao contar planos de backup", "error", err.Error())
+		return 0, handlePgDatabaseError(ctx, err)
+	}
+
+	return count, nil
+}
+
+func (bpr *backupPlanRepository) UpdateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan, check port.StorageCapacityCheck) error {
 	now := time.Now()
 
 	tx, err := bpr.db.Begin(ctx)
 	if err != nil {
-		slog.Error("Erro ao iniciar transação", "error", err.Error())
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
 	}
 	defer tx.Rollback(ctx)
 
+	if err := checkStorageCapacity(ctx, tx, backupPlan, check); err != nil {
+		return err
+	}
+
 	queryPlan := `
 		UPDATE backup_plans 
-		SET name = $1, backup_size_bytes = $2, device_id = $3, updated_at = $4
-    WHERE id = $5
+		SET name = $1, backup_size_bytes = $2, device_id = $3, retention_policy_id = $4, storage_target_id = $5, compression = $6, encryption = $7, updated_at = $8
+    WHERE id = $9
 	`
 
-	result, err := tx.Exec(ctx, queryPlan, backupPlan.Name, backupPlan.BackupSizeBytes, backupPlan.DeviceID, now, backupPlan.ID)
+	result, err := tx.Exec(ctx, queryPlan, backupPlan.Name, backupPlan.BackupSizeBytes, backupPlan.DeviceID, backupPlan.RetentionPolicyID, backupPlan.StorageTargetID, backupPlan.Compression, backupPlan.Encryption, now, backupPlan.ID)
 	if err != nil {
-		slog.Error("Erro ao atualizar na tabela plano de backup", "error", err)
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar na tabela plano de backup", "error", err)
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
-		slog.Error("Nenhuma linha foi afetada", "error", err)
-		return err
+		utils.Logger(ctx).ErrorContext(ctx, "Nenhuma linha foi afetada ao atualizar o plano de backup")
+		return domain.ErrDataNotFound
 	}
 
 	queryDelete := `DELETE FROM backup_plans_week_days WHERE backup_plan_id = $1`
 	_, err = tx.Exec(ctx, queryDelete, backupPlan.ID)
 	if err != nil {
-		slog.Error("Erro ao deletar dias da semana existentes", "error", err)
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao deletar dias da semana existentes", "error", err)
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	queryInsert := `
-		INSERT INTO backup_plans_week_days (backup_plan_id, day, time_day, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO backup_plans_week_days (backup_plan_id, day, time_day, backup_type, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
 	`
 
 	for _, day := range backupPlan.WeekDays {
-		_, err := tx.Exec(ctx, queryInsert, backupPlan.ID, day.Day, day.TimeDay, day.CreatedAt, now)
+		_, err := tx.Exec(ctx, queryInsert, backupPlan.ID, day.Day, day.TimeDay, day.BackupType, day.CreatedAt, now)
 		if err != nil {
-			slog.Error("Erro ao inserir novo dia da semana", "error", err)
-			return handlePgDatabaseError(err)
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir novo dia da semana", "error", err)
+			return handlePgDatabaseError(ctx, err)
 		}
 	}
 
+	_, err = tx.Exec(ctx, `DELETE FROM backup_plan_sources WHERE backup_plan_id = $1`, backupPlan.ID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao deletar origens existentes", "error", err)
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	err = insertBackupPlanSources(ctx, tx, backupPlan.ID, backupPlan.Sources, now)
+	if err != nil {
+		return err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
-		slog.Error("Erro ao fazer commit", "error", err)
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao fazer commit", "error", err)
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	return nil
 }
 
+// DeleteBackupPlan recusa o plano que ainda tem pontos de restauração
+// válidos: apagá-los aqui deixaria os backups no destino sem registro. O
+// plano fica bloqueado durante a verificação, para que o agente não reporte
+// um ponto novo entre ela e a remoção. Os pontos vencidos e o que depende do
+// plano saem junto com ele.
 func (bpr *backupPlanRepository) DeleteBackupPlan(ctx context.Context, id uuid.UUID) error {
 	tx, err := bpr.db.Begin(ctx)
 	if err != nil {
-		slog.Error("Erro ao iniciar transação", "error", err.Error())
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
 	}
 	defer tx.Rollback(ctx)
 
-	_, err = tx.Exec(ctx, `DELETE FROM backup_plans_week_days WHERE backup_plan_id = $1`, id)
+	var hasLivePoints bool
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM restore_points
+			WHERE backup_plan_id = bp.id AND (expires_at IS NULL OR expires_at > now())
+		)
+		FROM backup_plans bp
+		WHERE bp.id = $1
+		FOR UPDATE OF bp
+	`
+	err = tx.QueryRow(ctx, query, id).Scan(&hasLivePoints)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrDataNotFound
+	}
 	if err != nil {
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao verificar pontos de restauração do plano", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
 	}
 
-	_, err = tx.Exec(ctx, `DELETE FROM backup_plans WHERE id = $1`, id)
-	if err != nil {
-		return handlePgDatabaseError(err)
+	if hasLivePoints {
+		return domain.ErrConflictingData.WithDetails(map[string]string{"restore_points": "backup_plan.has_restore_points"})
+	}
+
+	statements := []struct {
+		query   string
+		message string
+	}{
+		{`DELETE FROM backup_plans_week_days WHERE backup_plan_id = $1`, "Erro ao deletar horários do plano de backup"},
+		{`DELETE FROM backup_plan_sources WHERE backup_plan_id = $1`, "Erro ao deletar origens do plano de backup"},
+		{`DELETE FROM verification_jobs WHERE backup_plan_id = $1`, "Erro ao deletar verificações do plano de backup"},
+		{`DELETE FROM verification_schedules WHERE backup_plan_id = $1`, "Erro ao deletar agenda de verificação do plano de backup"},
+		{`DELETE FROM size_alerts WHERE backup_plan_id = $1`, "Erro ao deletar alertas de tamanho do plano de backup"},
+		{`DELETE FROM restore_points WHERE backup_plan_id = $1`, "Erro ao deletar pontos de restauração vencidos do plano de backup"},
+		{`DELETE FROM backup_plans WHERE id = $1`, "Erro ao deletar plano de backup"},
+	}
+	for _, statement := range statements {
+		_, err = tx.Exec(ctx, statement.query, id)
+		if err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, statement.message, "error", err.Error())
+			return handlePgDatabaseError(ctx, err)
+		}
 	}
 
 	if err := tx.Commit(ctx); err != nil {
-		slog.Error("Erro ao fazer commit", "error", err.Error())
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao fazer commit", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	return nil
 }
+
+// checkStorageCapacity bloqueia o destino até o fim da transação, de modo que
+// outra gravação no mesmo destino espere e veja este plano ao listar.
+func checkStorageCapacity(ctx context.Context, tx pgx.Tx, backupPlan *domain.BackupPlan, check port.StorageCapacityCheck) error {
+	if check == nil || backupPlan.StorageTargetID == nil {
+		return nil
+	}
+
+	var target domain.StorageTarget
+	query := `SELECT ` + storageTargetColumns + ` FROM storage_targets WHERE id = $1 FOR UPDATE`
+	err := scanStorageTarget(tx.QueryRow(ctx, query, *backupPlan.StorageTargetID), &target)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrDataNotFound
+	}
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao bloquear destino de armazenamento", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	plans, err := listStorageTargetPlans(ctx, tx, target.ID)
+	if err != nil {
+		return err
+	}
+
+	return check(ctx, &target, plans)
+}
+
+func insertBackupPlanSources(ctx context.Context, tx pgx.Tx, backupPlanID uuid.UUID, sources []domain.BackupPlanSource, now time.Time) error {
+	query := `
+		INSERT INTO backup_plan_sources (id, backup_plan_id, kind, location, include, exclude, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	for _, source := range sources {
+		id := utils.Coalesce(source.ID, uuid.New())
+		_, err := tx.Exec(ctx, query, id, backupPlanID, source.Kind, source.Location, nonNil(source.Include), nonNil(source.Exclude), now, now)
+		if err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir origem do plano de backup", "error", err)
+			return handlePgDatabaseError(ctx, err)
+		}
+	}
+
+	return nil
+}
+
+// listBackupPlanSources devolve as origens agrupadas pelo id do plano.
+func (bpr *backupPlanRepository) listBackupPlanSources(ctx context.Context, backupPlanIDs []uuid.UUID) (map[uuid.UUID][]domain.BackupPlanSource, error) {
+	query := `
+		SELECT id, backup_plan_id, kind, location, include, exclude, created_at, updated_at
+		FROM backup_plan_sources
+		WHERE backup_plan_id = ANY($1)
+		ORDER BY created_at, location
+	`
+
+	rows, err := bpr.db.Query(ctx, query, backupPlanIDs)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar origens dos planos de backup", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	sources := make(map[uuid.UUID][]domain.BackupPlanSource, len(backupPlanIDs))
+	for rows.Next() {
+		var source domain.BackupPlanSource
+		err := rows.Scan(
+			&source.ID,
+			&source.BackupPlanID,
+			&source.Kind,
+			&source.Location,
+			&source.Include,
+			&source.Exclude,
+			&source.CreatedAt,
+			&source.UpdatedAt,
+		)
+		if err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler origem do plano de backup", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+		sources[source.BackupPlanID] = append(sources[source.BackupPlanID], source)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return sources, nil
+}
+
+// nonNil evita gravar NULL nas colunas de array, que são NOT NULL.
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
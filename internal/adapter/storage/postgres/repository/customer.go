@@ -2,11 +2,12 @@ package repository
 
 import (
 	"context"
-	"log/slog"
+	"errors"
 	"time"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 )
@@ -29,11 +30,11 @@ func (cr *customerRepository) CreateCustomer(ctx context.Context, customer *doma
 	`
 	result, err := cr.db.Exec(ctx, query, customer.ID, customer.Name, now, now)
 	if err != nil {
-		return handlePgDatabaseError(err)
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
-		slog.Error("Nenhuma linha foi afetada ao inserir cliente")
+		utils.Logger(ctx).ErrorContext(ctx, "Nenhuma linha foi afetada ao inserir cliente")
 		return domain.ErrDataNotFound
 	}
 
@@ -54,13 +55,13 @@ func (cr *customerRepository) GetCustomerByID(ctx context.Context, id uuid.UUID)
 		&customer.UpdatedAt,
 	)
 
-	if err == pgx.ErrNoRows {
+	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, domain.ErrDataNotFound
 	}
 
 	if err != nil {
-		slog.Error("Erro ao buscar cliente", "error", err.Error())
-		return nil, handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar cliente", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
 	}
 
 	return &customer, nil
@@ -80,13 +81,13 @@ func (cr *customerRepository) GetCustomerByName(ctx context.Context, name string
 		&customer.UpdatedAt,
 	)
 
-	if err == pgx.ErrNoRows {
+	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, domain.ErrDataNotFound
 	}
 
 	if err != nil {
-		slog.Error("Erro ao buscar cliente pelo nome", "error", err.Error())
-		return nil, handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar cliente pelo nome", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
 	}
 
 	return &customer, nil
@@ -105,8 +106,8 @@ func (cr *customerRepository) ListCustomers(ctx context.Context, page, limit int
 	`
 	rows, err := cr.db.Query(ctx, query, limit, offset)
 	if err != nil {
-		slog.Error("Erro ao buscar lista de clientes", "error", err.Error())
-		return nil, handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar lista de clientes", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
 	}
 	defer rows.Close()
 
@@ -118,8 +119,8 @@ func (cr *customerRepository) ListCustomers(ctx context.Context, page, limit int
 			&customer.UpdatedAt,
 		)
 		if err != nil {
-			slog.Error("Erro ao retornar a lista de clientes", "error", err.Error())
-			return nil, handlePgDatabaseError(err)
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao retornar a lista de clientes", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
 		}
 
 		customers = append(customers, customer)
@@ -128,6 +129,17 @@ func (cr *customerRepository) ListCustomers(ctx context.Context, page, limit int
 	return customers, nil
 }
 
+func (cr *customerRepository) CountCustomers(ctx context.Context) (int, error) {
+	var count int
+	err := cr.db.QueryRow(ctx, `SELECT COUNT(*) FROM customers`).Scan(&count)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao contar clientes", "error", err.Error())
+		return 0, handlePgDatabaseError(ctx, err)
+	}
+
+	return count, nil
+}
+
 func (cr *customerRepository) UpdateCustomer(ctx context.Context, customer *domain.Customer) error {
 	now := time.Now()
 	query := `
@@ -138,12 +150,12 @@ func (cr *customerRepository) UpdateCustomer(ctx context.Context, customer *doma
 	`
 	result, err := cr.db.Exec(ctx, query, customer.Name, now, customer.ID)
 	if err != nil {
-		slog.Error("Erro ao atualizar os dados do clientes", "error", err.Error())
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar os dados do clientes", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
-		slog.Error("Nenhuma linha foi afetada ao atualizar cliente")
+		utils.Logger(ctx).ErrorContext(ctx, "Nenhuma linha foi afetada ao atualizar cliente")
 		return domain.ErrDataNotFound
 	}
 
@@ -157,8 +169,8 @@ func (cr *customerRepository) DeleteCustomer(ctx context.Context, id uuid.UUID)
 	`
 	_, err := cr.db.Exec(ctx, query, id)
 	if err != nil {
-		slog.Error("Erro ao deletar cliente", "error", err)
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao deletar cliente", "error", err)
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	return nil
@@ -2,11 +2,12 @@ package repository
 
 import (
 	"context"
-	"log/slog"
+	"errors"
 	"time"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 )
@@ -29,12 +30,12 @@ func (dr *deviceRepository) CreateDevice(ctx context.Context, device *domain.Dev
 	`
 	result, err := dr.db.Exec(ctx, query, device.ID, device.Name, device.CustomerID, now, now)
 	if err != nil {
-		slog.Error("Erro ao criar dispositivo", "error", err.Error())
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao criar dispositivo", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
-		slog.Error("Nenhuma linha afetada ao criar dispositivo")
+		utils.Logger(ctx).ErrorContext(ctx, "Nenhuma linha afetada ao criar dispositivo")
 		return domain.ErrDataNotFound
 	}
 
@@ -56,13 +57,13 @@ func (dr *deviceRepository) GetDeviceByID(ctx context.Context, id uuid.UUID) (*d
 		&device.UpdatedAt,
 	)
 
-	if err == pgx.ErrNoRows {
+	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, domain.ErrDataNotFound
 	}
 
 	if err != nil {
-		slog.Error("Erro ao buscar dispositivo pelo id", "error", err.Error())
-		return nil, handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar dispositivo pelo id", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
 	}
 
 	return &device, nil
@@ -83,13 +84,13 @@ func (dr *deviceRepository) GetDeviceByCustomerID(ctx context.Context, id uuid.U
 		&device.UpdatedAt,
 	)
 
-	if err == pgx.ErrNoRows {
+	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, domain.ErrDataNotFound
 	}
 
 	if err != nil {
-		slog.Error("Erro ao buscar dispositivo pelo customer_id", "error", err.Error())
-		return nil, handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar dispositivo pelo customer_id", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
 	}
 
 	return &device, nil
@@ -108,8 +109,8 @@ func (dr *deviceRepository) ListDevices(ctx context.Context, page, limit int) ([
 	`
 	rows, err := dr.db.Query(ctx, query, limit, offset)
 	if err != nil {
-		slog.Error("Erro ao buscar os dispositivos", "error", err)
-		return nil, handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar os dispositivos", "error", err)
+		return nil, handlePgDatabaseError(ctx, err)
 	}
 	defer rows.Close()
 
@@ -122,8 +123,8 @@ func (dr *deviceRepository) ListDevices(ctx context.Context, page, limit int) ([
 			&device.UpdatedAt,
 		)
 		if err != nil {
-			slog.Error("Erro ao obter a lista de dispositivos", "error", err.Error())
-			return nil, handlePgDatabaseError(err)
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao obter a lista de dispositivos", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
 		}
 
 		devices = append(devices, device)
@@ -132,6 +133,17 @@ func (dr *deviceRepository) ListDevices(ctx context.Context, page, limit int) ([
 	return devices, nil
 }
 
+func (dr *deviceRepository) CountDevices(ctx context.Context) (int, error) {
+	var count int
+	err := dr.db.QueryRow(ctx, `SELECT COUNT(*) FROM devices`).Scan(&count)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao contar dispositivos", "error", err.Error())
+		return 0, handlePgDatabaseError(ctx, err)
+	}
+
+	return count, nil
+}
+
 func (dr *deviceRepository) UpdateDevice(ctx context.Context, device *domain.Device) error {
 	query := `
 		UPDATE devices
@@ -141,13 +153,13 @@ func (dr *deviceRepository) UpdateDevice(ctx context.Context, device *domain.Dev
 	`
 	result, err := dr.db.Exec(ctx, query, device.Name, device.CustomerID, time.Now(), device.ID)
 	if err != nil {
-		slog.Error("Erro ao atualizar os dados do dispositivo", "error", err.Error())
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar os dados do dispositivo", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
-		slog.Error("Nenhuma linha afetada ao atualizar dispositivo")
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Nenhuma linha afetada ao atualizar dispositivo")
+		return domain.ErrDataNotFound
 	}
 
 	return nil
@@ -160,8 +172,8 @@ func (dr *deviceRepository) DeleteDevice(ctx context.Context, id uuid.UUID) erro
 	`
 	_, err := dr.db.Exec(ctx, query, id)
 	if err != nil {
-		slog.Error("Erro ao deletar os dados do dispositivo", "error", err.Error())
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao deletar os dados do dispositivo", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	return nil
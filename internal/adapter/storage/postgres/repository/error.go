@@ -1,10 +1,11 @@
 package repository
 
 import (
+	"context"
 	"errors"
-	"log/slog"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
@@ -14,24 +15,24 @@ const (
 	ErrPgUniqueConstraint    = "23505"
 )
 
-func handlePgDatabaseError(err error) error {
+func handlePgDatabaseError(ctx context.Context, err error) error {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
 		switch pgErr.Code {
 		case ErrPgNotNullViolation:
-			slog.Error("Campo obrigatório não preenchido", "column", pgErr.ColumnName)
+			utils.Logger(ctx).ErrorContext(ctx, "Campo obrigatório não preenchido", "column", pgErr.ColumnName)
 			return domain.ErrBadRequest
 		case ErrPgForeignKeyViolation:
-			slog.Error("Violação de chave estrangeira", "constraint", pgErr.ConstraintName)
+			utils.Logger(ctx).ErrorContext(ctx, "Violação de chave estrangeira", "constraint", pgErr.ConstraintName)
 			return domain.ErrBadRequest
 		case ErrPgUniqueConstraint:
-			slog.Error("Violação de unicidade (chave duplicada)", "constraint", pgErr.ConstraintName)
+			utils.Logger(ctx).ErrorContext(ctx, "Violação de unicidade (chave duplicada)", "constraint", pgErr.ConstraintName)
 			return domain.ErrConflictingData
 		default:
-			slog.Error("Erro PostgreSQL não tratado", "code", pgErr.Code, "message", pgErr.Message, "detail", pgErr.Detail, "where", pgErr.Where)
-			return domain.ErrInternal
+			utils.Logger(ctx).ErrorContext(ctx, "Erro PostgreSQL não tratado", "code", pgErr.Code, "message", pgErr.Message, "detail", pgErr.Detail, "where", pgErr.Where)
+			return domain.ErrInternal.Wrap(err)
 		}
 	}
 
-	return err
+	return domain.ErrInternal.Wrap(err)
 }
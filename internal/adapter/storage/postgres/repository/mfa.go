@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+)
+
+type mfaRepository struct {
+	db *postgres.DB
+}
+
+func NewMFARepository(db *postgres.DB) *mfaRepository {
+	return &mfaRepository{
+		db,
+	}
+}
+
+// SetTOTPSecret grava um novo segredo ainda não confirmado e substitui os
+// códigos de recuperação anteriores.
+func (mr *mfaRepository) SetTOTPSecret(ctx context.Context, userID uuid.UUID, secret string, recoveryCodeHashes []string) error {
+	tx, err := mr.db.Begin(ctx)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE users
+		SET totp_secret = $1, mfa_enabled = false, totp_last_step = 0, updated_at = now()
+		WHERE id = $2
+	`
+	result, err := tx.Exec(ctx, query, secret, userID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao gravar segredo TOTP", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM user_recovery_codes WHERE user_id = $1`, userID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao remover códigos de recuperação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	queryCode := `
+		INSERT INTO user_recovery_codes (id, user_id, code_hash)
+		VALUES ($1, $2, $3)
+	`
+	for _, hash := range recoveryCodeHashes {
+		_, err := tx.Exec(ctx, queryCode, uuid.New(), userID, hash)
+		if err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir código de recuperação", "error", err.Error())
+			return handlePgDatabaseError(ctx, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao fazer commit", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (mr *mfaRepository) EnableTOTP(ctx context.Context, userID uuid.UUID, step int64) error {
+	query := `
+		UPDATE users
+		SET mfa_enabled = true, totp_last_step = $1, updated_at = now()
+		WHERE id = $2 AND totp_secret <> ''
+	`
+	result, err := mr.db.Exec(ctx, query, step, userID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao ativar MFA", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+// UpdateTOTPStep só avança o passo; um código já usado não atualiza nenhuma
+// linha e é tratado como inexistente.
+func (mr *mfaRepository) UpdateTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	query := `
+		UPDATE users
+		SET totp_last_step = $1
+		WHERE id = $2 AND totp_last_step < $1
+	`
+	result, err := mr.db.Exec(ctx, query, step, userID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar passo TOTP", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+func (mr *mfaRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	query := `
+		UPDATE user_recovery_codes
+		SET used_at = now()
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+	`
+	result, err := mr.db.Exec(ctx, query, userID, codeHash)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao usar código de recuperação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+func (mr *mfaRepository) DisableTOTP(ctx context.Context, userID uuid.UUID) error {
+	tx, err := mr.db.Begin(ctx)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE users
+		SET mfa_enabled = false, totp_secret = '', totp_last_step = 0, updated_at = now()
+		WHERE id = $1
+	`
+	result, err := tx.Exec(ctx, query, userID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao desativar MFA", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM user_recovery_codes WHERE user_id = $1`, userID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao remover códigos de recuperação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao fazer commit", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
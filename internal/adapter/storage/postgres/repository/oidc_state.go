@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+type oidcStateRepository struct {
+	db *postgres.DB
+}
+
+func NewOIDCStateRepository(db *postgres.DB) *oidcStateRepository {
+	return &oidcStateRepository{
+		db,
+	}
+}
+
+// CreateOIDCState também descarta os states expirados, que ficam para trás
+// quando o usuário abandona o login no provedor.
+func (osr *oidcStateRepository) CreateOIDCState(ctx context.Context, state *domain.OIDCState) error {
+	_, err := osr.db.Exec(ctx, `DELETE FROM oidc_states WHERE expires_at <= now()`)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao remover states OIDC expirados", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	query := `
+		INSERT INTO oidc_states (state_hash, code_verifier, nonce, user_id, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, now())
+	`
+	result, err := osr.db.Exec(ctx, query, state.StateHash, state.CodeVerifier, state.Nonce, state.UserID, state.ExpiresAt)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir state OIDC", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		utils.Logger(ctx).ErrorContext(ctx, "Nenhuma linha foi afetada ao criar state OIDC")
+		return domain.ErrInternal
+	}
+
+	return nil
+}
+
+// ConsumeOIDCState remove o state na mesma instrução que o valida, de modo
+// que cada callback só possa ser usado uma vez.
+func (osr *oidcStateRepository) ConsumeOIDCState(ctx context.Context, stateHash string) (*domain.OIDCState, error) {
+	var state domain.OIDCState
+	query := `
+		DELETE FROM oidc_states
+		WHERE state_hash = $1 AND expires_at > now()
+		RETURNING state_hash, code_verifier, nonce, user_id, expires_at
+	`
+	err := osr.db.QueryRow(ctx, query, stateHash).Scan(
+		&state.StateHash,
+		&state.CodeVerifier,
+		&state.Nonce,
+		&state.UserID,
+		&state.ExpiresAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao consumir state OIDC", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return &state, nil
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+const rateLimitRetention = 24 * time.Hour
+
+// rateLimitRepository compartilha os buckets entre réplicas. Cada chave é
+// travada com SELECT ... FOR UPDATE enquanto o saldo é recalculado.
+type rateLimitRepository struct {
+	db *postgres.DB
+}
+
+func NewRateLimitRepository(db *postgres.DB) *rateLimitRepository {
+	return &rateLimitRepository{
+		db,
+	}
+}
+
+func (rlr *rateLimitRepository) Allow(ctx context.Context, key string, limit domain.RateLimit) (bool, time.Duration, error) {
+	tx, err := rlr.db.Begin(ctx)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return false, 0, handlePgDatabaseError(ctx, err)
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now()
+	_, err = tx.Exec(ctx, `
+		INSERT INTO rate_limits (key, tokens, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO NOTHING
+	`, key, float64(limit.Burst), now)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao criar bucket de rate limit", "error", err.Error())
+		return false, 0, handlePgDatabaseError(ctx, err)
+	}
+
+	var tokens float64
+	var updatedAt time.Time
+	err = tx.QueryRow(ctx, `SELECT tokens, updated_at FROM rate_limits WHERE key = $1 FOR UPDATE`, key).Scan(&tokens, &updatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, 0, domain.ErrInternal
+	}
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar bucket de rate limit", "error", err.Error())
+		return false, 0, handlePgDatabaseError(ctx, err)
+	}
+
+	tokens, allowed, retryAfter := limit.Take(tokens, now.Sub(updatedAt))
+
+	_, err = tx.Exec(ctx, `UPDATE rate_limits SET tokens = $1, updated_at = $2 WHERE key = $3`, tokens, now, key)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar bucket de rate limit", "error", err.Error())
+		return false, 0, handlePgDatabaseError(ctx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao fazer commit", "error", err.Error())
+		return false, 0, handlePgDatabaseError(ctx, err)
+	}
+
+	return allowed, retryAfter, nil
+}
+
+// Sweep apaga os buckets sem uso há mais de um dia, que já estariam cheios.
+func (rlr *rateLimitRepository) Sweep(ctx context.Context) error {
+	_, err := rlr.db.Exec(ctx, `DELETE FROM rate_limits WHERE updated_at < $1`, time.Now().Add(-rateLimitRetention))
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao limpar buckets de rate limit", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
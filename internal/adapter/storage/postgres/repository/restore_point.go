@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const restorePointColumns = `rp.id, rp.backup_plan_id, rp.type, rp.size_bytes, rp.checksum, rp.location, rp.created_at, rp.expires_at, rp.reported_at`
+
+type restorePointRepository struct {
+	db *postgres.DB
+}
+
+func NewRestorePointRepository(db *postgres.DB) *restorePointRepository {
+	return &restorePointRepository{
+		db,
+	}
+}
+
+func scanRestorePoint(row pgx.Row, point *domain.RestorePoint) error {
+	var sizeBytes int64
+
+	err := row.Scan(
+		&point.ID,
+		&point.BackupPlanID,
+		&point.Type,
+		&sizeBytes,
+		&point.Checksum,
+		&point.Location,
+		&point.CreatedAt,
+		&point.ExpiresAt,
+		&point.ReportedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	point.SizeBytes = big.NewInt(sizeBytes)
+	return nil
+}
+
+func (rpr *restorePointRepository) SaveRestorePoints(ctx context.Context, points []domain.RestorePoint) error {
+	tx, err := rpr.db.Begin(ctx)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO restore_points (id, backup_plan_id, type, size_bytes, checksum, location, created_at, reported_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, now())
+		ON CONFLICT (id) DO UPDATE
+		SET type = EXCLUDED.type, size_bytes = EXCLUDED.size_bytes, checksum = EXCLUDED.checksum,
+			location = EXCLUDED.location, created_at = EXCLUDED.created_at, reported_at = now()
+		WHERE restore_points.backup_plan_id = EXCLUDED.backup_plan_id
+	`
+	for _, point := range points {
+		result, err := tx.Exec(ctx, query,
+			point.ID,
+			point.BackupPlanID,
+			point.Type,
+			point.SizeBytes.Int64(),
+			point.Checksum,
+			point.Location,
+			point.CreatedAt,
+		)
+		if err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao gravar ponto de restauração", "error", err.Error())
+			return handlePgDatabaseError(ctx, err)
+		}
+
+		if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+			return domain.ErrConflictingData
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao fazer commit", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (rpr *restorePointRepository) GetRestorePointByID(ctx context.Context, id uuid.UUID) (*domain.RestorePoint, error) {
+	var point domain.RestorePoint
+	query := `SELECT ` + restorePointColumns + ` FROM restore_points rp WHERE rp.id = $1`
+
+	err := scanRestorePoint(rpr.db.QueryRow(ctx, query, id), &point)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar ponto de restauração", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return &point, nil
+}
+
+func (rpr *restorePointRepository) ListRestorePoints(ctx context.Context, backupPlanID uuid.UUID, page, limit int) ([]domain.RestorePoint, error) {
+	offset := (page - 1) * limit
+	query := `
+		SELECT ` + restorePointColumns + `
+		FROM restore_points rp
+		WHERE rp.backup_plan_id = $1
+		ORDER BY rp.created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	return rpr.listRestorePoints(ctx, query, backupPlanID, limit, offset)
+}
+
+func (rpr *restorePointRepository) ListRestorePointHistory(ctx context.Context, backupPlanID uuid.UUID) ([]domain.RestorePoint, error) {
+	query := `
+		SELECT ` + restorePointColumns + `
+		FROM restore_points rp
+		WHERE rp.backup_plan_id = $1
+		ORDER BY rp.created_at, rp.id
+	`
+
+	return rpr.listRestorePoints(ctx, query, backupPlanID)
+}
+
+func (rpr *restorePointRepository) ListLiveRestorePoints(ctx context.Context, backupPlanID uuid.UUID, now time.Time) ([]domain.RestorePoint, error) {
+	query := `
+		SELECT ` + restorePointColumns + `
+		FROM restore_points rp
+		WHERE rp.backup_plan_id = $1 AND (rp.expires_at IS NULL OR rp.expires_at > $2)
+		ORDER BY rp.created_at DESC
+	`
+
+	return rpr.listRestorePoints(ctx, query, backupPlanID, now)
+}
+
+func (rpr *restorePointRepository) ListExpiredRestorePoints(ctx context.Context, backupPlanID uuid.UUID, now time.Time) ([]domain.RestorePoint, error) {
+	query := `
+		SELECT ` + restorePointColumns + `
+		FROM restore_points rp
+		WHERE rp.backup_plan_id = $1 AND rp.expires_at <= $2
+		ORDER BY rp.created_at
+	`
+
+	return rpr.listRestorePoints(ctx, query, backupPlanID, now)
+}
+
+func (rpr *restorePointRepository) ListDeviceRestorePoints(ctx context.Context, deviceID uuid.UUID, at time.Time) ([]domain.RestorePoint, error) {
+	query := `
+		SELECT DISTINCT ON (rp.backup_plan_id) ` + restorePointColumns + `
+		FROM restore_points rp
+			INNER JOIN backup_plans bp ON (bp.id = rp.backup_plan_id)
+		WHERE bp.device_id = $1 AND rp.created_at <= $2 AND (rp.expires_at IS NULL OR rp.expires_at > now())
+		ORDER BY rp.backup_plan_id, rp.created_at DESC
+	`
+
+	return rpr.listRestorePoints(ctx, query, deviceID, at)
+}
+
+func (rpr *restorePointRepository) listRestorePoints(ctx context.Context, query string, args ...any) ([]domain.RestorePoint, error) {
+	rows, err := rpr.db.Query(ctx, query, args...)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao listar pontos de restauração", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	points := []domain.RestorePoint{}
+	for rows.Next() {
+		var point domain.RestorePoint
+		if err := scanRestorePoint(rows, &point); err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler ponto de restauração", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+		points = append(points, point)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao percorrer pontos de restauração", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return points, nil
+}
+
+func (rpr *restorePointRepository) UpdateRestorePointExpiries(ctx context.Context, points []domain.RestorePoint) error {
+	tx, err := rpr.db.Begin(ctx)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, point := range points {
+		_, err := tx.Exec(ctx, `UPDATE restore_points SET expires_at = $1 WHERE id = $2`, point.ExpiresAt, point.ID)
+		if err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar validade do ponto de restauração", "error", err.Error())
+			return handlePgDatabaseError(ctx, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao fazer commit", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (rpr *restorePointRepository) DeleteRestorePoint(ctx context.Context, id uuid.UUID) error {
+	result, err := rpr.db.Exec(ctx, `DELETE FROM restore_points WHERE id = $1`, id)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao excluir ponto de restauração", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const restoreRequestColumns = `id, backup_plan_id, restore_point_id, target_device_id, target_path, reason, status, requested_by, reviewed_by, review_note, reviewed_at, started_at, completed_at, result, created_at, updated_at`
+
+type restoreRequestRepository struct {
+	db *postgres.DB
+}
+
+func NewRestoreRequestRepository(db *postgres.DB) *restoreRequestRepository {
+	return &restoreRequestRepository{
+		db,
+	}
+}
+
+func scanRestoreRequest(row pgx.Row, request *domain.RestoreRequest) error {
+	return row.Scan(
+		&request.ID,
+		&request.BackupPlanID,
+		&request.RestorePointID,
+		&request.TargetDeviceID,
+		&request.TargetPath,
+		&request.Reason,
+		&request.Status,
+		&request.RequestedBy,
+		&request.ReviewedBy,
+		&request.ReviewNote,
+		&request.ReviewedAt,
+		&request.StartedAt,
+		&request.CompletedAt,
+		&request.Result,
+		&request.CreatedAt,
+		&request.UpdatedAt,
+	)
+}
+
+func (rrr *restoreRequestRepository) CreateRestoreRequest(ctx context.Context, request *domain.RestoreRequest) error {
+	query := `
+		INSERT INTO restore_requests (id, backup_plan_id, restore_point_id, target_device_id, target_path, reason, status, requested_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, now(), now())
+		RETURNING created_at, updated_at
+	`
+	err := rrr.db.QueryRow(ctx, query,
+		request.ID,
+		request.BackupPlanID,
+		request.RestorePointID,
+		request.TargetDeviceID,
+		request.TargetPath,
+		request.Reason,
+		request.Status,
+		request.RequestedBy,
+	).Scan(&request.CreatedAt, &request.UpdatedAt)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir pedido de restauração", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (rrr *restoreRequestRepository) GetRestoreRequestByID(ctx context.Context, id uuid.UUID) (*domain.RestoreRequest, error) {
+	var request domain.RestoreRequest
+	query := `SELECT ` + restoreRequestColumns + ` FROM restore_requests WHERE id = $1`
+
+	err := scanRestoreRequest(rrr.db.QueryRow(ctx, query, id), &request)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar pedido de restauração", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return &request, nil
+}
+
+func (rrr *restoreRequestRepository) ListRestoreRequests(ctx context.Context, status domain.RestoreRequestStatus, page, limit int) ([]domain.RestoreRequest, error) {
+	offset := (page - 1) * limit
+	query := `
+		SELECT ` + restoreRequestColumns + `
+		FROM restore_requests
+		WHERE $1 = '' OR status::text = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	return rrr.listRestoreRequests(ctx, query, string(status), limit, offset)
+}
+
+func (rrr *restoreRequestRepository) ListRestoreRequestsByDevice(ctx context.Context, deviceID uuid.UUID, status domain.RestoreRequestStatus) ([]domain.RestoreRequest, error) {
+	query := `
+		SELECT ` + restoreRequestColumns + `
+		FROM restore_requests
+		WHERE target_device_id = $1 AND status = $2
+		ORDER BY reviewed_at, created_at
+	`
+
+	return rrr.listRestoreRequests(ctx, query, deviceID, status)
+}
+
+func (rrr *restoreRequestRepository) listRestoreRequests(ctx context.Context, query string, args ...any) ([]domain.RestoreRequest, error) {
+	rows, err := rrr.db.Query(ctx, query, args...)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao listar pedidos de restauração", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	requests := []domain.RestoreRequest{}
+	for rows.Next() {
+		var request domain.RestoreRequest
+		if err := scanRestoreRequest(rows, &request); err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler pedido de restauração", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+		requests = append(requests, request)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao percorrer pedidos de restauração", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return requests, nil
+}
+
+func (rrr *restoreRequestRepository) UpdateRestoreRequest(ctx context.Context, request *domain.RestoreRequest, from domain.RestoreRequestStatus) error {
+	query := `
+		UPDATE restore_requests
+		SET status = $1, reviewed_by = $2, review_note = $3, reviewed_at = $4, started_at = $5, completed_at = $6, result = $7, updated_at = now()
+		WHERE id = $8 AND status = $9
+		RETURNING updated_at
+	`
+	err := rrr.db.QueryRow(ctx, query,
+		request.Status,
+		request.ReviewedBy,
+		request.ReviewNote,
+		request.ReviewedAt,
+		request.StartedAt,
+		request.CompletedAt,
+		request.Result,
+		request.ID,
+		from,
+	).Scan(&request.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrRestoreRequestState
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar pedido de restauração", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (rrr *restoreRequestRepository) CountRestoreRequestsByStatus(ctx context.Context) (map[domain.RestoreRequestStatus]int, error) {
+	return countByStatus[domain.RestoreRequestStatus](ctx, rrr.db, `SELECT status, COUNT(*) FROM restore_requests GROUP BY status`, "pedidos de restauração")
+}
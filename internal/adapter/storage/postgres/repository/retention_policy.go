@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const retentionPolicyColumns = `id, customer_id, name, keep_last, keep_daily, keep_weekly, keep_monthly, keep_yearly, max_age_days, created_at, updated_at`
+
+type retentionPolicyRepository struct {
+	db *postgres.DB
+}
+
+func NewRetentionPolicyRepository(db *postgres.DB) *retentionPolicyRepository {
+	return &retentionPolicyRepository{
+		db,
+	}
+}
+
+func scanRetentionPolicy(row pgx.Row, policy *domain.RetentionPolicy) error {
+	return row.Scan(
+		&policy.ID,
+		&policy.CustomerID,
+		&policy.Name,
+		&policy.KeepLast,
+		&policy.KeepDaily,
+		&policy.KeepWeekly,
+		&policy.KeepMonthly,
+		&policy.KeepYearly,
+		&policy.MaxAgeDays,
+		&policy.CreatedAt,
+		&policy.UpdatedAt,
+	)
+}
+
+func (rpr *retentionPolicyRepository) CreateRetentionPolicy(ctx context.Context, policy *domain.RetentionPolicy) error {
+	query := `
+		INSERT INTO retention_policies (id, customer_id, name, keep_last, keep_daily, keep_weekly, keep_monthly, keep_yearly, max_age_days, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, now(), now())
+		RETURNING created_at, updated_at
+	`
+	err := rpr.db.QueryRow(ctx, query,
+		policy.ID,
+		policy.CustomerID,
+		policy.Name,
+		policy.KeepLast,
+		policy.KeepDaily,
+		policy.KeepWeekly,
+		policy.KeepMonthly,
+		policy.KeepYearly,
+		policy.MaxAgeDays,
+	).Scan(&policy.CreatedAt, &policy.UpdatedAt)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir política de retenção", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (rpr *retentionPolicyRepository) GetRetentionPolicyByID(ctx context.Context, id uuid.UUID) (*domain.RetentionPolicy, error) {
+	var policy domain.RetentionPolicy
+	query := `SELECT ` + retentionPolicyColumns + ` FROM retention_policies WHERE id = $1`
+
+	err := scanRetentionPolicy(rpr.db.QueryRow(ctx, query, id), &policy)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar política de retenção", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return &policy, nil
+}
+
+// ListRetentionPolicies filtra pelo cliente quando customerID é informado.
+func (rpr *retentionPolicyRepository) ListRetentionPolicies(ctx context.Context, customerID uuid.UUID, page, limit int) ([]domain.RetentionPolicy, error) {
+	offset := (page - 1) * limit
+	query := `
+		SELECT ` + retentionPolicyColumns + `
+		FROM retention_policies
+		WHERE $1::uuid IS NULL OR customer_id = $1
+		ORDER BY name
+		LIMIT $2 OFFSET $3
+	`
+
+	var filter *uuid.UUID
+	if customerID != uuid.Nil {
+		filter = &customerID
+	}
+
+	rows, err := rpr.db.Query(ctx, query, filter, limit, offset)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao listar políticas de retenção", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	var policies []domain.RetentionPolicy
+	for rows.Next() {
+		var policy domain.RetentionPolicy
+		if err := scanRetentionPolicy(rows, &policy); err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler política de retenção", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+		policies = append(policies, policy)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao percorrer políticas de retenção", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return policies, nil
+}
+
+func (rpr *retentionPolicyRepository) UpdateRetentionPolicy(ctx context.Context, policy *domain.RetentionPolicy) error {
+	query := `
+		UPDATE retention_policies
+		SET name = $1, keep_last = $2, keep_daily = $3, keep_weekly = $4, keep_monthly = $5, keep_yearly = $6, max_age_days = $7, updated_at = now()
+		WHERE id = $8
+	`
+	result, err := rpr.db.Exec(ctx, query,
+		policy.Name,
+		policy.KeepLast,
+		policy.KeepDaily,
+		policy.KeepWeekly,
+		policy.KeepMonthly,
+		policy.KeepYearly,
+		policy.MaxAgeDays,
+		policy.ID,
+	)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar política de retenção", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+func (rpr *retentionPolicyRepository) DeleteRetentionPolicy(ctx context.Context, id uuid.UUID) error {
+	result, err := rpr.db.Exec(ctx, `DELETE FROM retention_policies WHERE id = $1`, id)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao excluir política de retenção", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+func (rpr *retentionPolicyRepository) CountBackupPlansByRetentionPolicy(ctx context.Context, id uuid.UUID) (int, error) {
+	var count int
+	err := rpr.db.QueryRow(ctx, `SELECT COUNT(*) FROM backup_plans WHERE retention_policy_id = $1`, id).Scan(&count)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao contar planos da política de retenção", "error", err.Error())
+		return 0, handlePgDatabaseError(ctx, err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const secretColumns = `id, key_id, data_key, ciphertext, created_at, updated_at`
+
+type secretRepository struct {
+	db *postgres.DB
+}
+
+func NewSecretRepository(db *postgres.DB) *secretRepository {
+	return &secretRepository{
+		db,
+	}
+}
+
+func scanSecret(row pgx.Row, secret *domain.Secret) error {
+	return row.Scan(
+		&secret.ID,
+		&secret.KeyID,
+		&secret.DataKey,
+		&secret.Ciphertext,
+		&secret.CreatedAt,
+		&secret.UpdatedAt,
+	)
+}
+
+func (sr *secretRepository) GetSecretByID(ctx context.Context, id uuid.UUID) (*domain.Secret, error) {
+	var secret domain.Secret
+	query := `SELECT ` + secretColumns + ` FROM secrets WHERE id = $1`
+
+	err := scanSecret(sr.db.QueryRow(ctx, query, id), &secret)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar segredo", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return &secret, nil
+}
+
+func (sr *secretRepository) ListSecretsToRotate(ctx context.Context, keyID string, after uuid.UUID, limit int) ([]domain.Secret, error) {
+	query := `
+		SELECT ` + secretColumns + `
+		FROM secrets
+		WHERE key_id <> $1 AND id > $2
+		ORDER BY id
+		LIMIT $3
+	`
+
+	rows, err := sr.db.Query(ctx, query, keyID, after, limit)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao listar segredos para rotação", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	var secrets []domain.Secret
+	for rows.Next() {
+		var secret domain.Secret
+		if err := scanSecret(rows, &secret); err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler segredo", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+		secrets = append(secrets, secret)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao percorrer segredos", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return secrets, nil
+}
+
+func (sr *secretRepository) UpdateSecret(ctx context.Context, secret *domain.Secret) error {
+	query := `
+		UPDATE secrets
+		SET key_id = $1, data_key = $2, ciphertext = $3, updated_at = now()
+		WHERE id = $4
+	`
+	result, err := sr.db.Exec(ctx, query, secret.KeyID, secret.DataKey, secret.Ciphertext, secret.ID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar segredo", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+// saveSecret grava o segredo dentro da transação de quem o referencia,
+// substituindo o conteúdo quando o id já existe.
+func saveSecret(ctx context.Context, tx pgx.Tx, secret *domain.Secret) error {
+	query := `
+		INSERT INTO secrets (id, key_id, data_key, ciphertext, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, now(), now())
+		ON CONFLICT (id) DO UPDATE
+		SET key_id = EXCLUDED.key_id, data_key = EXCLUDED.data_key, ciphertext = EXCLUDED.ciphertext, updated_at = now()
+	`
+	_, err := tx.Exec(ctx, query, secret.ID, secret.KeyID, secret.DataKey, secret.Ciphertext)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao gravar segredo", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
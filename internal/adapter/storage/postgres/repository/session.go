@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const sessionColumns = `id, user_id, ip, user_agent, mfa, created_at, last_seen_at, expires_at, revoked_at`
+
+type sessionRepository struct {
+	db *postgres.DB
+}
+
+func NewSessionRepository(db *postgres.DB) *sessionRepository {
+	return &sessionRepository{
+		db,
+	}
+}
+
+func scanSession(row pgx.Row, session *domain.Session) error {
+	return row.Scan(
+		&session.ID,
+		&session.UserID,
+		&session.IP,
+		&session.UserAgent,
+		&session.MFA,
+		&session.CreatedAt,
+		&session.LastSeenAt,
+		&session.ExpiresAt,
+		&session.RevokedAt,
+	)
+}
+
+// CreateSession também remove as sessões já expiradas do usuário, mantendo a
+// tabela limitada às sessões que ainda podem ser usadas ou encerradas.
+func (sr *sessionRepository) CreateSession(ctx context.Context, session *domain.Session) error {
+	tx, err := sr.db.Begin(ctx)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `DELETE FROM sessions WHERE user_id = $1 AND expires_at <= now()`, session.UserID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao remover sessões expiradas", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	query := `
+		INSERT INTO sessions (id, user_id, ip, user_agent, mfa, created_at, last_seen_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, now(), now(), $6)
+		RETURNING created_at, last_seen_at
+	`
+	err = tx.QueryRow(ctx, query, session.ID, session.UserID, session.IP, session.UserAgent, session.MFA, session.ExpiresAt).Scan(&session.CreatedAt, &session.LastSeenAt)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir sessão", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao confirmar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (sr *sessionRepository) GetSessionByID(ctx context.Context, id uuid.UUID) (*domain.Session, error) {
+	var session domain.Session
+	query := `SELECT ` + sessionColumns + ` FROM sessions WHERE id = $1`
+
+	err := scanSession(sr.db.QueryRow(ctx, query, id), &session)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar sessão", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return &session, nil
+}
+
+func (sr *sessionRepository) ListActiveSessionsByUser(ctx context.Context, userID uuid.UUID) ([]domain.Session, error) {
+	query := `
+		SELECT ` + sessionColumns + `
+		FROM sessions
+		WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > now()
+		ORDER BY last_seen_at DESC
+	`
+	rows, err := sr.db.Query(ctx, query, userID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao listar sessões", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	var sessions []domain.Session
+	for rows.Next() {
+		var session domain.Session
+		if err := scanSession(rows, &session); err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler sessão", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao percorrer sessões", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return sessions, nil
+}
+
+func (sr *sessionRepository) RevokeSession(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE sessions
+		SET revoked_at = now()
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+	result, err := sr.db.Exec(ctx, query, id)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao encerrar sessão", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+func (sr *sessionRepository) RevokeUserSessions(ctx context.Context, userID uuid.UUID) error {
+	query := `
+		UPDATE sessions
+		SET revoked_at = now()
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`
+	_, err := sr.db.Exec(ctx, query, userID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao encerrar sessões do usuário", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+// RevokeOtherUserSessions encerra as sessões do usuário, exceto keepID.
+func (sr *sessionRepository) RevokeOtherUserSessions(ctx context.Context, userID, keepID uuid.UUID) error {
+	query := `
+		UPDATE sessions
+		SET revoked_at = now()
+		WHERE user_id = $1 AND id <> $2 AND revoked_at IS NULL
+	`
+	_, err := sr.db.Exec(ctx, query, userID, keepID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao encerrar sessões do usuário", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+// TouchSession atualiza o último acesso com resolução de um minuto, evitando
+// uma escrita por requisição.
+func (sr *sessionRepository) TouchSession(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE sessions
+		SET last_seen_at = now()
+		WHERE id = $1 AND last_seen_at < now() - interval '1 minute'
+	`
+	_, err := sr.db.Exec(ctx, query, id)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar último acesso da sessão", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+)
+
+type sizeAlertRepository struct {
+	db *postgres.DB
+}
+
+func NewSizeAlertRepository(db *postgres.DB) *sizeAlertRepository {
+	return &sizeAlertRepository{
+		db,
+	}
+}
+
+func (sar *sizeAlertRepository) SaveSizeAlerts(ctx context.Context, alerts []domain.SizeAlert) error {
+	tx, err := sar.db.Begin(ctx)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO size_alerts (id, backup_plan_id, restore_point_id, type, kind, severity, size_bytes, baseline_median, baseline_mad, point_created_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now())
+		ON CONFLICT (restore_point_id) DO NOTHING
+	`
+	for _, alert := range alerts {
+		_, err := tx.Exec(ctx, query,
+			alert.ID,
+			alert.BackupPlanID,
+			alert.RestorePointID,
+			alert.Type,
+			alert.Kind,
+			alert.Severity,
+			alert.SizeBytes,
+			alert.BaselineMedian,
+			alert.BaselineMAD,
+			alert.PointCreatedAt,
+		)
+		if err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao gravar alerta de tamanho", "error", err.Error())
+			return handlePgDatabaseError(ctx, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao fazer commit", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (sar *sizeAlertRepository) ListSizeAlerts(ctx context.Context, severity domain.SizeAlertSeverity, page, limit int) ([]domain.SizeAlert, error) {
+	offset := (page - 1) * limit
+	query := `
+		SELECT id, backup_plan_id, restore_point_id, type, kind, severity, size_bytes, baseline_median, baseline_mad, point_created_at, created_at
+		FROM size_alerts
+		WHERE $1 = '' OR severity::text = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := sar.db.Query(ctx, query, string(severity), limit, offset)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao listar alertas de tamanho", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	alerts := []domain.SizeAlert{}
+	for rows.Next() {
+		var alert domain.SizeAlert
+		err := rows.Scan(
+			&alert.ID,
+			&alert.BackupPlanID,
+			&alert.RestorePointID,
+			&alert.Type,
+			&alert.Kind,
+			&alert.Severity,
+			&alert.SizeBytes,
+			&alert.BaselineMedian,
+			&alert.BaselineMAD,
+			&alert.PointCreatedAt,
+			&alert.CreatedAt,
+		)
+		if err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler alerta de tamanho", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+		alerts = append(alerts, alert)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao percorrer alertas de tamanho", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return alerts, nil
+}
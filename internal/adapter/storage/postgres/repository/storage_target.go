@@ -0,0 +1,282 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const storageTargetColumns = `id, customer_id, name, kind, endpoint, capacity_bytes, credentials_secret_id, created_at, updated_at`
+
+type storageTargetRepository struct {
+	db *postgres.DB
+}
+
+func NewStorageTargetRepository(db *postgres.DB) *storageTargetRepository {
+	return &storageTargetRepository{
+		db,
+	}
+}
+
+func scanStorageTarget(row pgx.Row, target *domain.StorageTarget) error {
+	var capacityBytes int64
+
+	err := row.Scan(
+		&target.ID,
+		&target.CustomerID,
+		&target.Name,
+		&target.Kind,
+		&target.Endpoint,
+		&capacityBytes,
+		&target.CredentialsSecretID,
+		&target.CreatedAt,
+		&target.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	target.CapacityBytes = big.NewInt(capacityBytes)
+	return nil
+}
+
+func (str *storageTargetRepository) CreateStorageTarget(ctx context.Context, target *domain.StorageTarget, credentials *domain.Secret) error {
+	tx, err := str.db.Begin(ctx)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if credentials != nil {
+		if err := saveSecret(ctx, tx, credentials); err != nil {
+			return err
+		}
+		target.CredentialsSecretID = &credentials.ID
+	}
+
+	query := `
+		INSERT INTO storage_targets (id, customer_id, name, kind, endpoint, capacity_bytes, credentials_secret_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, now(), now())
+		RETURNING created_at, updated_at
+	`
+	err = tx.QueryRow(ctx, query,
+		target.ID,
+		target.CustomerID,
+		target.Name,
+		target.Kind,
+		target.Endpoint,
+		target.CapacityBytes.Int64(),
+		target.CredentialsSecretID,
+	).Scan(&target.CreatedAt, &target.UpdatedAt)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir destino de armazenamento", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao fazer commit", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (str *storageTargetRepository) GetStorageTargetByID(ctx context.Context, id uuid.UUID) (*domain.StorageTarget, error) {
+	var target domain.StorageTarget
+	query := `SELECT ` + storageTargetColumns + ` FROM storage_targets WHERE id = $1`
+
+	err := scanStorageTarget(str.db.QueryRow(ctx, query, id), &target)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar destino de armazenamento", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return &target, nil
+}
+
+// ListStorageTargets com customerID informado devolve os destinos do cliente
+// e os compartilhados, que são os que os planos dele podem usar.
+func (str *storageTargetRepository) ListStorageTargets(ctx context.Context, customerID uuid.UUID, page, limit int) ([]domain.StorageTarget, error) {
+	offset := (page - 1) * limit
+	query := `
+		SELECT ` + storageTargetColumns + `
+		FROM storage_targets
+		WHERE $1::uuid IS NULL OR customer_id = $1 OR customer_id IS NULL
+		ORDER BY name
+		LIMIT $2 OFFSET $3
+	`
+
+	var filter *uuid.UUID
+	if customerID != uuid.Nil {
+		filter = &customerID
+	}
+
+	rows, err := str.db.Query(ctx, query, filter, limit, offset)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao listar destinos de armazenamento", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	var targets []domain.StorageTarget
+	for rows.Next() {
+		var target domain.StorageTarget
+		if err := scanStorageTarget(rows, &target); err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler destino de armazenamento", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+		targets = append(targets, target)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao percorrer destinos de armazenamento", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return targets, nil
+}
+
+// UpdateStorageTarget mantém as credenciais atuais quando credentials é nil.
+func (str *storageTargetRepository) UpdateStorageTarget(ctx context.Context, target *domain.StorageTarget, credentials *domain.Secret) error {
+	tx, err := str.db.Begin(ctx)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if credentials != nil {
+		if err := saveSecret(ctx, tx, credentials); err != nil {
+			return err
+		}
+		target.CredentialsSecretID = &credentials.ID
+	}
+
+	query := `
+		UPDATE storage_targets
+		SET name = $1, kind = $2, endpoint = $3, capacity_bytes = $4, credentials_secret_id = COALESCE($5, credentials_secret_id), updated_at = now()
+		WHERE id = $6
+	`
+	result, err := tx.Exec(ctx, query,
+		target.Name,
+		target.Kind,
+		target.Endpoint,
+		target.CapacityBytes.Int64(),
+		target.CredentialsSecretID,
+		target.ID,
+	)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar destino de armazenamento", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao fazer commit", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+// DeleteStorageTarget exclui também as credenciais do destino.
+func (str *storageTargetRepository) DeleteStorageTarget(ctx context.Context, id uuid.UUID) error {
+	tx, err := str.db.Begin(ctx)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var secretID *uuid.UUID
+	err = tx.QueryRow(ctx, `DELETE FROM storage_targets WHERE id = $1 RETURNING credentials_secret_id`, id).Scan(&secretID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao excluir destino de armazenamento", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if secretID != nil {
+		_, err = tx.Exec(ctx, `DELETE FROM secrets WHERE id = $1`, *secretID)
+		if err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao excluir credenciais do destino de armazenamento", "error", err.Error())
+			return handlePgDatabaseError(ctx, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao fazer commit", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+// ListStorageTargetPlans devolve os planos gravados no destino com o número
+// de execuções semanais de cada um.
+func (str *storageTargetRepository) ListStorageTargetPlans(ctx context.Context, id uuid.UUID) ([]domain.StorageTargetPlan, error) {
+	return listStorageTargetPlans(ctx, str.db, id)
+}
+
+// planQuerier é atendido pelo pool e por pgx.Tx, para que a listagem também
+// rode dentro da transação que grava o plano.
+type planQuerier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func listStorageTargetPlans(ctx context.Context, db planQuerier, id uuid.UUID) ([]domain.StorageTargetPlan, error) {
+	query := `
+		SELECT bp.id, bp.name, bp.backup_size_bytes, bp.retention_policy_id, COUNT(wd.id)
+		FROM backup_plans bp
+			LEFT JOIN backup_plans_week_days wd ON (bp.id = wd.backup_plan_id)
+		WHERE bp.storage_target_id = $1
+		GROUP BY bp.id
+		ORDER BY bp.name
+	`
+
+	rows, err := db.Query(ctx, query, id)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao listar planos do destino de armazenamento", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	var plans []domain.StorageTargetPlan
+	for rows.Next() {
+		var plan domain.StorageTargetPlan
+		var backupSizeBytes int64
+
+		err := rows.Scan(&plan.BackupPlanID, &plan.Name, &backupSizeBytes, &plan.RetentionPolicyID, &plan.RunsPerWeek)
+		if err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler plano do destino de armazenamento", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+
+		plan.BackupSizeBytes = big.NewInt(backupSizeBytes)
+		plans = append(plans, plan)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao percorrer planos do destino de armazenamento", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return plans, nil
+}
@@ -2,11 +2,12 @@ package repository
 
 import (
 	"context"
-	"log/slog"
+	"errors"
 	"time"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 )
@@ -25,17 +26,17 @@ func (ur *userRepository) CreateUser(ctx context.Context, user *domain.User) err
 	now := time.Now()
 
 	query := `
-		INSERT INTO users (id, fullname, email, username, password, role, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO users (id, fullname, email, username, password, role, email_verified_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
-	result, err := ur.db.Exec(ctx, query, user.ID, user.Fullname, user.Email, user.Username, user.Password, user.Role, now, now)
+	result, err := ur.db.Exec(ctx, query, user.ID, user.Fullname, user.Email, user.Username, user.Password, user.Role, user.EmailVerifiedAt, now, now)
 	if err != nil {
-		slog.Error("Erro ao inserir usuário", "error", err.Error())
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir usuário", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
-		slog.Error("Nenhuma linha foi afetada ao criar usuário")
+		utils.Logger(ctx).ErrorContext(ctx, "Nenhuma linha foi afetada ao criar usuário")
 		return domain.ErrDataNotFound
 	}
 
@@ -45,7 +46,7 @@ func (ur *userRepository) CreateUser(ctx context.Context, user *domain.User) err
 func (ur *userRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
 	var user domain.User
 	query := `
-		SELECT id, fullname, email, username, password, role, created_at, updated_at
+		SELECT id, fullname, email, username, password, role, failed_login_attempts, locked_until, mfa_enabled, totp_secret, totp_last_step, email_verified_at, must_change_password, created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
@@ -56,17 +57,24 @@ func (ur *userRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*domai
 		&user.Username,
 		&user.Password,
 		&user.Role,
+		&user.FailedLoginAttempts,
+		&user.LockedUntil,
+		&user.MFAEnabled,
+		&user.TOTPSecret,
+		&user.TOTPLastStep,
+		&user.EmailVerifiedAt,
+		&user.MustChangePassword,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
 
-	if err == pgx.ErrNoRows {
+	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, domain.ErrDataNotFound
 	}
 
 	if err != nil {
-		slog.Error("Erro ao buscar usuário pelo id", "error", err.Error())
-		return nil, handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar usuário pelo id", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
 	}
 
 	return &user, nil
@@ -75,7 +83,7 @@ func (ur *userRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*domai
 func (ur *userRepository) GetUserByUsername(ctx context.Context, username string) (*domain.User, error) {
 	var user domain.User
 	query := `
-		SELECT id, fullname, email, username, password, role, created_at, updated_at
+		SELECT id, fullname, email, username, password, role, failed_login_attempts, locked_until, mfa_enabled, totp_secret, totp_last_step, email_verified_at, must_change_password, created_at, updated_at
 		FROM users
 		WHERE username = $1
 	`
@@ -86,17 +94,24 @@ func (ur *userRepository) GetUserByUsername(ctx context.Context, username string
 		&user.Username,
 		&user.Password,
 		&user.Role,
+		&user.FailedLoginAttempts,
+		&user.LockedUntil,
+		&user.MFAEnabled,
+		&user.TOTPSecret,
+		&user.TOTPLastStep,
+		&user.EmailVerifiedAt,
+		&user.MustChangePassword,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
 
-	if err == pgx.ErrNoRows {
+	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, domain.ErrDataNotFound
 	}
 
 	if err != nil {
-		slog.Error("Erro ao buscar usuário pelo username")
-		return nil, handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar usuário pelo username")
+		return nil, handlePgDatabaseError(ctx, err)
 	}
 
 	return &user, nil
@@ -105,7 +120,7 @@ func (ur *userRepository) GetUserByUsername(ctx context.Context, username string
 func (ur *userRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
 	var user domain.User
 	query := `
-		SELECT id, fullname, email, username, password, role, created_at, updated_at
+		SELECT id, fullname, email, username, password, role, failed_login_attempts, locked_until, mfa_enabled, totp_secret, totp_last_step, email_verified_at, must_change_password, created_at, updated_at
 		FROM users
 		WHERE email = $1
 	`
@@ -116,17 +131,24 @@ func (ur *userRepository) GetUserByEmail(ctx context.Context, email string) (*do
 		&user.Username,
 		&user.Password,
 		&user.Role,
+		&user.FailedLoginAttempts,
+		&user.LockedUntil,
+		&user.MFAEnabled,
+		&user.TOTPSecret,
+		&user.TOTPLastStep,
+		&user.EmailVerifiedAt,
+		&user.MustChangePassword,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
 
-	if err == pgx.ErrNoRows {
+	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, domain.ErrDataNotFound
 	}
 
 	if err != nil {
-		slog.Error("Erro ao buscar usuário pelo e-mail", "error", err.Error())
-		return nil, handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar usuário pelo e-mail", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
 	}
 
 	return &user, nil
@@ -138,14 +160,14 @@ func (ur *userRepository) ListUsers(ctx context.Context, page, limit int) ([]dom
 	offset := (page - 1) * limit
 
 	query := `
-		SELECT id, fullname, email, username, password, role, created_at, updated_at
+		SELECT id, fullname, email, username, password, role, failed_login_attempts, locked_until, mfa_enabled, totp_secret, totp_last_step, email_verified_at, must_change_password, created_at, updated_at
 		FROM users
 		ORDER BY username
 		LIMIT $1 OFFSET $2
 	`
 	rows, err := ur.db.Query(ctx, query, limit, offset)
 	if err != nil {
-		slog.Error("Erro ao buscar usuários", "error", err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar usuários", "error", err)
 		return nil, err
 	}
 	defer rows.Close()
@@ -158,12 +180,19 @@ func (ur *userRepository) ListUsers(ctx context.Context, page, limit int) ([]dom
 			&user.Username,
 			&user.Password,
 			&user.Role,
+			&user.FailedLoginAttempts,
+			&user.LockedUntil,
+			&user.MFAEnabled,
+			&user.TOTPSecret,
+			&user.TOTPLastStep,
+			&user.EmailVerifiedAt,
+			&user.MustChangePassword,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		)
 		if err != nil {
-			slog.Error("Erro ao obter lista de usuários", "error", err.Error())
-			return nil, handlePgDatabaseError(err)
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao obter lista de usuários", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
 		}
 
 		users = append(users, user)
@@ -181,12 +210,12 @@ func (ur *userRepository) UpdateUser(ctx context.Context, user *domain.User) err
 	`
 	result, err := ur.db.Exec(ctx, query, user.Fullname, user.Email, user.Username, user.Password, user.Role, now, user.ID)
 	if err != nil {
-		slog.Error("Erro ao atualizar o usuário", "error", err.Error())
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar o usuário", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
-		slog.Error("Nenhuma linha foi afetada ao atualizar usuário")
+		utils.Logger(ctx).ErrorContext(ctx, "Nenhuma linha foi afetada ao atualizar usuário")
 		return domain.ErrDataNotFound
 	}
 
@@ -200,12 +229,300 @@ func (ur *userRepository) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	`
 	result, err := ur.db.Exec(ctx, query, id)
 	if err != nil {
-		slog.Error("Erro ao deletar usuário", "error", err.Error())
-		return handlePgDatabaseError(err)
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao deletar usuário", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		utils.Logger(ctx).ErrorContext(ctx, "Nenhuma linha foi afetada ao deletar usuário")
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+func (ur *userRepository) IncrementFailedLogins(ctx context.Context, id uuid.UUID) (int, error) {
+	var attempts int
+	query := `
+		UPDATE users
+		SET failed_login_attempts = failed_login_attempts + 1
+		WHERE id = $1
+		RETURNING failed_login_attempts
+	`
+	err := ur.db.QueryRow(ctx, query, id).Scan(&attempts)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao registrar falha de login", "error", err.Error())
+		return 0, handlePgDatabaseError(ctx, err)
+	}
+
+	return attempts, nil
+}
+
+func (ur *userRepository) LockUser(ctx context.Context, id uuid.UUID, until time.Time) error {
+	query := `
+		UPDATE users
+		SET locked_until = $1
+		WHERE id = $2
+	`
+	result, err := ur.db.Exec(ctx, query, until, id)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao bloquear usuário", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+func (ur *userRepository) ResetFailedLogins(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE users
+		SET failed_login_attempts = 0, locked_until = NULL
+		WHERE id = $1
+	`
+	result, err := ur.db.Exec(ctx, query, id)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao desbloquear usuário", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+// ChangePassword grava a nova senha e move a anterior para o histórico,
+// mantendo apenas as historySize mais recentes.
+func (ur *userRepository) ChangePassword(ctx context.Context, id uuid.UUID, password string, historySize int) error {
+	tx, err := ur.db.Begin(ctx)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if historySize > 0 {
+		queryHistory := `
+			INSERT INTO user_password_history (user_id, password_hash)
+			SELECT id, password FROM users WHERE id = $1
+		`
+		_, err = tx.Exec(ctx, queryHistory, id)
+		if err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao gravar histórico de senhas", "error", err.Error())
+			return handlePgDatabaseError(ctx, err)
+		}
+	}
+
+	query := `
+		UPDATE users
+		SET password = $1, must_change_password = false, updated_at = now()
+		WHERE id = $2
+	`
+	result, err := tx.Exec(ctx, query, password, id)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar senha do usuário", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	queryPrune := `
+		DELETE FROM user_password_history
+		WHERE user_id = $1 AND id NOT IN (
+			SELECT id FROM user_password_history
+			WHERE user_id = $1
+			ORDER BY created_at DESC
+			LIMIT $2
+		)
+	`
+	_, err = tx.Exec(ctx, queryPrune, id, historySize)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao limpar histórico de senhas", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao fazer commit", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+// RehashPassword troca apenas o formato do hash da mesma senha, sem passar
+// pelo histórico.
+func (ur *userRepository) RehashPassword(ctx context.Context, id uuid.UUID, password string) error {
+	query := `
+		UPDATE users
+		SET password = $1
+		WHERE id = $2
+	`
+	result, err := ur.db.Exec(ctx, query, password, id)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar hash da senha", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+func (ur *userRepository) SetMustChangePassword(ctx context.Context, id uuid.UUID, mustChange bool) error {
+	query := `
+		UPDATE users
+		SET must_change_password = $1, updated_at = now()
+		WHERE id = $2
+	`
+	result, err := ur.db.Exec(ctx, query, mustChange, id)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar troca de senha obrigatória", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+func (ur *userRepository) ListPasswordHistory(ctx context.Context, id uuid.UUID, limit int) ([]string, error) {
+	query := `
+		SELECT password_hash
+		FROM user_password_history
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+	rows, err := ur.db.Query(ctx, query, id, limit)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar histórico de senhas", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	var hashes []string
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler histórico de senhas", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+		hashes = append(hashes, hash)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao percorrer histórico de senhas", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return hashes, nil
+}
+
+func (ur *userRepository) MarkEmailVerified(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE users
+		SET email_verified_at = COALESCE(email_verified_at, now()), updated_at = now()
+		WHERE id = $1
+	`
+	result, err := ur.db.Exec(ctx, query, id)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao confirmar e-mail do usuário", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+func (ur *userRepository) GetUserByOIDCSubject(ctx context.Context, subject string) (*domain.User, error) {
+	var user domain.User
+	query := `
+		SELECT id, fullname, email, username, password, role, failed_login_attempts, locked_until, mfa_enabled, totp_secret, totp_last_step, email_verified_at, must_change_password, created_at, updated_at
+		FROM users
+		WHERE oidc_subject = $1
+	`
+	err := ur.db.QueryRow(ctx, query, subject).Scan(
+		&user.ID,
+		&user.Fullname,
+		&user.Email,
+		&user.Username,
+		&user.Password,
+		&user.Role,
+		&user.FailedLoginAttempts,
+		&user.LockedUntil,
+		&user.MFAEnabled,
+		&user.TOTPSecret,
+		&user.TOTPLastStep,
+		&user.EmailVerifiedAt,
+		&user.MustChangePassword,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar usuário pelo subject OIDC", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return &user, nil
+}
+
+// LinkOIDCSubject só vincula usuários ainda sem subject, para que uma conta
+// nunca passe de uma identidade do provedor para outra.
+func (ur *userRepository) LinkOIDCSubject(ctx context.Context, id uuid.UUID, subject string) error {
+	query := `
+		UPDATE users
+		SET oidc_subject = $2, updated_at = now()
+		WHERE id = $1 AND (oidc_subject IS NULL OR oidc_subject = $2)
+	`
+	result, err := ur.db.Exec(ctx, query, id, subject)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao vincular subject OIDC ao usuário", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrConflictingData
+	}
+
+	return nil
+}
+
+func (ur *userRepository) SetUserRole(ctx context.Context, id uuid.UUID, role domain.UserRole) error {
+	query := `
+		UPDATE users
+		SET role = $2, updated_at = now()
+		WHERE id = $1
+	`
+	result, err := ur.db.Exec(ctx, query, id, role)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao alterar papel do usuário", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
 	}
 
 	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
-		slog.Error("Nenhuma linha foi afetada ao deletar usuário")
 		return domain.ErrDataNotFound
 	}
 
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type userTokenRepository struct {
+	db *postgres.DB
+}
+
+func NewUserTokenRepository(db *postgres.DB) *userTokenRepository {
+	return &userTokenRepository{
+		db,
+	}
+}
+
+func (utr *userTokenRepository) CreateUserToken(ctx context.Context, token *domain.UserToken) error {
+	query := `
+		INSERT INTO user_tokens (id, user_id, purpose, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, now())
+	`
+	result, err := utr.db.Exec(ctx, query, token.ID, token.UserID, token.Purpose, token.TokenHash, token.ExpiresAt)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir token de usuário", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		utils.Logger(ctx).ErrorContext(ctx, "Nenhuma linha foi afetada ao criar token de usuário")
+		return domain.ErrInternal
+	}
+
+	return nil
+}
+
+func (utr *userTokenRepository) FindUserToken(ctx context.Context, purpose domain.UserTokenPurpose, tokenHash string) (uuid.UUID, error) {
+	var userID uuid.UUID
+	query := `
+		SELECT user_id
+		FROM user_tokens
+		WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > now()
+	`
+	err := utr.db.QueryRow(ctx, query, tokenHash, purpose).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return uuid.Nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar token de usuário", "error", err.Error())
+		return uuid.Nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return userID, nil
+}
+
+// ConsumeUserToken marca o token como usado na mesma instrução que o valida,
+// garantindo o uso único mesmo com requisições concorrentes.
+func (utr *userTokenRepository) ConsumeUserToken(ctx context.Context, purpose domain.UserTokenPurpose, tokenHash string) (uuid.UUID, error) {
+	var userID uuid.UUID
+	query := `
+		UPDATE user_tokens
+		SET used_at = now()
+		WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > now()
+		RETURNING user_id
+	`
+	err := utr.db.QueryRow(ctx, query, tokenHash, purpose).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return uuid.Nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao consumir token de usuário", "error", err.Error())
+		return uuid.Nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return userID, nil
+}
+
+func (utr *userTokenRepository) DeleteUserTokens(ctx context.Context, userID uuid.UUID, purpose domain.UserTokenPurpose) error {
+	query := `
+		DELETE FROM user_tokens
+		WHERE user_id = $1 AND purpose = $2
+	`
+	_, err := utr.db.Exec(ctx, query, userID, purpose)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao remover tokens de usuário", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,315 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/adapter/storage/postgres"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const verificationJobColumns = `vj.id, vj.backup_plan_id, vj.kind, vj.status, vj.scheduled_at, vj.restore_point_id, vj.result, vj.completed_at, vj.created_at, vj.updated_at`
+
+type verificationRepository struct {
+	db *postgres.DB
+}
+
+func NewVerificationRepository(db *postgres.DB) *verificationRepository {
+	return &verificationRepository{
+		db,
+	}
+}
+
+func scanVerificationJob(row pgx.Row, job *domain.VerificationJob) error {
+	return row.Scan(
+		&job.ID,
+		&job.BackupPlanID,
+		&job.Kind,
+		&job.Status,
+		&job.ScheduledAt,
+		&job.RestorePointID,
+		&job.Result,
+		&job.CompletedAt,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	)
+}
+
+func (vr *verificationRepository) SaveVerificationSchedule(ctx context.Context, schedule *domain.VerificationSchedule) error {
+	query := `
+		INSERT INTO verification_schedules (backup_plan_id, kind, interval_days, grace_days, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, now(), now())
+		ON CONFLICT (backup_plan_id) DO UPDATE
+		SET kind = EXCLUDED.kind, interval_days = EXCLUDED.interval_days, grace_days = EXCLUDED.grace_days, updated_at = now()
+		RETURNING created_at, updated_at
+	`
+	err := vr.db.QueryRow(ctx, query,
+		schedule.BackupPlanID,
+		schedule.Kind,
+		schedule.IntervalDays,
+		schedule.GraceDays,
+	).Scan(&schedule.CreatedAt, &schedule.UpdatedAt)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao gravar agenda de verificação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (vr *verificationRepository) GetVerificationSchedule(ctx context.Context, backupPlanID uuid.UUID) (*domain.VerificationSchedule, error) {
+	var schedule domain.VerificationSchedule
+	query := `
+		SELECT backup_plan_id, kind, interval_days, grace_days, created_at, updated_at
+		FROM verification_schedules
+		WHERE backup_plan_id = $1
+	`
+
+	err := vr.db.QueryRow(ctx, query, backupPlanID).Scan(
+		&schedule.BackupPlanID,
+		&schedule.Kind,
+		&schedule.IntervalDays,
+		&schedule.GraceDays,
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar agenda de verificação", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return &schedule, nil
+}
+
+func (vr *verificationRepository) DeleteVerificationSchedule(ctx context.Context, backupPlanID uuid.UUID) error {
+	tx, err := vr.db.Begin(ctx)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao iniciar transação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `DELETE FROM verification_schedules WHERE backup_plan_id = $1`, backupPlanID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao excluir agenda de verificação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if rowsAffected := result.RowsAffected(); rowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	// Sem agenda, a verificação pendente não deve mais ser entregue ao agente.
+	_, err = tx.Exec(ctx, `DELETE FROM verification_jobs WHERE backup_plan_id = $1 AND status = 'pending'`, backupPlanID)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao excluir verificações pendentes", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao fazer commit", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (vr *verificationRepository) ListVerificationStatuses(ctx context.Context, backupPlanIDs []uuid.UUID) ([]domain.VerificationStatus, error) {
+	query := `
+		SELECT vs.backup_plan_id, bp.name, vs.kind, vs.interval_days, vs.grace_days, vs.created_at, vs.updated_at,
+			(SELECT max(vj.completed_at) FROM verification_jobs vj
+			 WHERE vj.backup_plan_id = vs.backup_plan_id AND vj.status = 'succeeded')
+		FROM verification_schedules vs
+			INNER JOIN backup_plans bp ON (bp.id = vs.backup_plan_id)
+		WHERE $1::uuid[] IS NULL OR vs.backup_plan_id = ANY($1)
+		ORDER BY bp.name, vs.backup_plan_id
+	`
+	rows, err := vr.db.Query(ctx, query, backupPlanIDs)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao listar agendas de verificação", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	statuses := []domain.VerificationStatus{}
+	for rows.Next() {
+		var status domain.VerificationStatus
+		err := rows.Scan(
+			&status.BackupPlanID,
+			&status.BackupPlanName,
+			&status.Schedule.Kind,
+			&status.Schedule.IntervalDays,
+			&status.Schedule.GraceDays,
+			&status.Schedule.CreatedAt,
+			&status.Schedule.UpdatedAt,
+			&status.LastVerifiedAt,
+		)
+		if err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler agenda de verificação", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+		status.Schedule.BackupPlanID = status.BackupPlanID
+		statuses = append(statuses, status)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao percorrer agendas de verificação", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return statuses, nil
+}
+
+func (vr *verificationRepository) CreateVerificationJob(ctx context.Context, job *domain.VerificationJob) error {
+	query := `
+		INSERT INTO verification_jobs (id, backup_plan_id, kind, status, scheduled_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, now(), now())
+		RETURNING created_at, updated_at
+	`
+	err := vr.db.QueryRow(ctx, query,
+		job.ID,
+		job.BackupPlanID,
+		job.Kind,
+		job.Status,
+		job.ScheduledAt,
+	).Scan(&job.CreatedAt, &job.UpdatedAt)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao inserir verificação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (vr *verificationRepository) GetVerificationJobByID(ctx context.Context, id uuid.UUID) (*domain.VerificationJob, error) {
+	var job domain.VerificationJob
+	query := `SELECT ` + verificationJobColumns + ` FROM verification_jobs vj WHERE vj.id = $1`
+
+	err := scanVerificationJob(vr.db.QueryRow(ctx, query, id), &job)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao buscar verificação", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return &job, nil
+}
+
+func (vr *verificationRepository) ListPendingVerificationJobs(ctx context.Context, backupPlanIDs []uuid.UUID) ([]domain.VerificationJob, error) {
+	query := `
+		SELECT ` + verificationJobColumns + `
+		FROM verification_jobs vj
+		WHERE vj.backup_plan_id = ANY($1) AND vj.status = 'pending'
+		ORDER BY vj.scheduled_at, vj.id
+	`
+
+	return vr.listVerificationJobs(ctx, query, backupPlanIDs)
+}
+
+func (vr *verificationRepository) ListVerificationJobs(ctx context.Context, backupPlanID uuid.UUID, page, limit int) ([]domain.VerificationJob, error) {
+	offset := (page - 1) * limit
+	query := `
+		SELECT ` + verificationJobColumns + `
+		FROM verification_jobs vj
+		WHERE vj.backup_plan_id = $1
+		ORDER BY vj.created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	return vr.listVerificationJobs(ctx, query, backupPlanID, limit, offset)
+}
+
+func (vr *verificationRepository) listVerificationJobs(ctx context.Context, query string, args ...any) ([]domain.VerificationJob, error) {
+	rows, err := vr.db.Query(ctx, query, args...)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao listar verificações", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	jobs := []domain.VerificationJob{}
+	for rows.Next() {
+		var job domain.VerificationJob
+		if err := scanVerificationJob(rows, &job); err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler verificação", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao percorrer verificações", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return jobs, nil
+}
+
+func (vr *verificationRepository) UpdateVerificationJob(ctx context.Context, job *domain.VerificationJob, from domain.VerificationJobStatus) error {
+	query := `
+		UPDATE verification_jobs
+		SET status = $1, restore_point_id = $2, result = $3, completed_at = $4, updated_at = now()
+		WHERE id = $5 AND status = $6
+		RETURNING updated_at
+	`
+	err := vr.db.QueryRow(ctx, query,
+		job.Status,
+		job.RestorePointID,
+		job.Result,
+		job.CompletedAt,
+		job.ID,
+		from,
+	).Scan(&job.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrVerificationJobState
+	}
+
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao atualizar verificação", "error", err.Error())
+		return handlePgDatabaseError(ctx, err)
+	}
+
+	return nil
+}
+
+func (vr *verificationRepository) CountVerificationJobsByStatus(ctx context.Context) (map[domain.VerificationJobStatus]int, error) {
+	return countByStatus[domain.VerificationJobStatus](ctx, vr.db, `SELECT status, COUNT(*) FROM verification_jobs GROUP BY status`, "verificações")
+}
+
+// countByStatus lê pares (status, quantidade) de uma consulta agrupada.
+func countByStatus[S ~string](ctx context.Context, db *postgres.DB, query, subject string) (map[S]int, error) {
+	rows, err := db.Query(ctx, query)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao contar "+subject+" por status", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+	defer rows.Close()
+
+	counts := map[S]int{}
+	for rows.Next() {
+		var status S
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao ler contagem de "+subject+" por status", "error", err.Error())
+			return nil, handlePgDatabaseError(ctx, err)
+		}
+		counts[status] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao percorrer contagem de "+subject+" por status", "error", err.Error())
+		return nil, handlePgDatabaseError(ctx, err)
+	}
+
+	return counts, nil
+}
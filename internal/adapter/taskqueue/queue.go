@@ -0,0 +1,118 @@
+package taskqueue
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+)
+
+var (
+	ErrQueueFull   = errors.New("fila de tarefas cheia")
+	ErrQueueClosed = errors.New("fila de tarefas encerrada")
+)
+
+type job struct {
+	ctx  context.Context
+	task string
+	fn   func(ctx context.Context) error
+}
+
+// Queue executa as tarefas com um número fixo de workers e uma fila limitada,
+// para que um pico de requisições não abra conexões SMTP sem limite. Quem
+// enfileira não espera: com a fila cheia a tarefa é recusada na hora.
+type Queue struct {
+	jobs chan job
+	wg   sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+
+	// stop cancela as tarefas em andamento quando o prazo do Shutdown acaba.
+	stop   context.Context
+	cancel context.CancelFunc
+}
+
+func New(workers, size int) *Queue {
+	stop, cancel := context.WithCancel(context.Background())
+	q := &Queue{
+		jobs:   make(chan job, size),
+		stop:   stop,
+		cancel: cancel,
+	}
+
+	q.wg.Add(workers)
+	for range workers {
+		go q.work()
+	}
+
+	return q
+}
+
+func (q *Queue) Enqueue(ctx context.Context, task string, fn func(ctx context.Context) error) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.closed {
+		return ErrQueueClosed
+	}
+
+	select {
+	case q.jobs <- job{context.WithoutCancel(ctx), task, fn}:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Shutdown para de aceitar tarefas e espera as enfileiradas terminarem. Se
+// ctx acabar antes, as que ainda rodam são canceladas e o erro de ctx é
+// devolvido.
+func (q *Queue) Shutdown(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.jobs)
+	}
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		q.cancel()
+		return nil
+	case <-ctx.Done():
+		q.cancel()
+		return ctx.Err()
+	}
+}
+
+func (q *Queue) work() {
+	defer q.wg.Done()
+
+	for j := range q.jobs {
+		q.run(j)
+	}
+}
+
+func (q *Queue) run(j job) {
+	ctx, cancel := context.WithCancel(j.ctx)
+	defer cancel()
+	defer context.AfterFunc(q.stop, cancel)()
+
+	defer func() {
+		if r := recover(); r != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Pânico em tarefa em segundo plano", "task", j.task, "panic", r)
+		}
+	}()
+
+	if err := j.fn(ctx); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro em tarefa em segundo plano", "task", j.task, "error", err.Error())
+	}
+}
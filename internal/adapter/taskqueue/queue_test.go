@@ -0,0 +1,95 @@
+package taskqueue
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestQueueShutdownDrainsPendingTasks(t *testing.T) {
+	q := New(1, 10)
+
+	var done atomic.Int32
+	for range 5 {
+		err := q.Enqueue(context.Background(), "teste", func(ctx context.Context) error {
+			time.Sleep(10 * time.Millisecond)
+			done.Add(1)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Enqueue: %v", err)
+		}
+	}
+
+	if err := q.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	if done.Load() != 5 {
+		t.Errorf("tarefas concluídas = %d, want 5", done.Load())
+	}
+
+	err := q.Enqueue(context.Background(), "teste", func(ctx context.Context) error { return nil })
+	if !errors.Is(err, ErrQueueClosed) {
+		t.Errorf("Enqueue após Shutdown = %v, want %v", err, ErrQueueClosed)
+	}
+}
+
+func TestQueueRejectsWhenFull(t *testing.T) {
+	q := New(1, 1)
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	block := func(ctx context.Context) error {
+		close(started)
+		<-release
+		return nil
+	}
+	if err := q.Enqueue(context.Background(), "ocupa o worker", block); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	<-started
+
+	noop := func(ctx context.Context) error { return nil }
+	if err := q.Enqueue(context.Background(), "ocupa a fila", noop); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	if err := q.Enqueue(context.Background(), "excedente", noop); !errors.Is(err, ErrQueueFull) {
+		t.Errorf("Enqueue com a fila cheia = %v, want %v", err, ErrQueueFull)
+	}
+
+	close(release)
+	if err := q.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+}
+
+func TestQueueShutdownTimeoutCancelsRunningTasks(t *testing.T) {
+	q := New(1, 1)
+	started := make(chan struct{})
+	canceled := make(chan struct{})
+
+	err := q.Enqueue(context.Background(), "lenta", func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		close(canceled)
+		return ctx.Err()
+	})
+	if err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := q.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Shutdown = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("a tarefa em andamento não foi cancelada")
+	}
+}
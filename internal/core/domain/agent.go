@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AgentToken autentica o agente instalado em um dispositivo. Cada dispositivo
+// tem no máximo um token; emitir outro invalida o anterior.
+type AgentToken struct {
+	ID         uuid.UUID
+	DeviceID   uuid.UUID
+	Prefix     string
+	TokenHash  string
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// AgentConfig é tudo o que o agente precisa para executar os planos do
+// dispositivo. Version muda sempre que qualquer parte da configuração muda.
+type AgentConfig struct {
+	DeviceID    uuid.UUID
+	Version     string
+	GeneratedAt time.Time
+	Plans       []AgentPlan
+}
+
+// AgentPlan junta ao plano a política de retenção e o destino, com as
+// credenciais já decifradas, para que o agente não precise de outras chamadas.
+type AgentPlan struct {
+	BackupPlan        BackupPlan
+	RetentionPolicy   *RetentionPolicy
+	StorageTarget     *StorageTarget
+	TargetCredentials *StorageTargetCredentials
+}
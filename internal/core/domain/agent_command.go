@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AgentCommandType string
+
+const (
+	AgentCommandRunBackup   AgentCommandType = "run_backup"
+	AgentCommandVerify      AgentCommandType = "verify"
+	AgentCommandRestoreTest AgentCommandType = "restore_test"
+	AgentCommandUpdateAgent AgentCommandType = "update_agent"
+)
+
+// AgentCommandStatus: pending até o agente confirmar o recebimento
+// (acknowledged) e depois succeeded ou failed. Só comandos pendentes expiram;
+// um comando já confirmado pode levar mais que a validade para terminar.
+type AgentCommandStatus string
+
+const (
+	AgentCommandPending      AgentCommandStatus = "pending"
+	AgentCommandAcknowledged AgentCommandStatus = "acknowledged"
+	AgentCommandSucceeded    AgentCommandStatus = "succeeded"
+	AgentCommandFailed       AgentCommandStatus = "failed"
+	AgentCommandExpired      AgentCommandStatus = "expired"
+)
+
+// AgentCommand é uma ação sob demanda para o agente do dispositivo.
+// BackupPlanID é obrigatório nos comandos de plano e AgentVersion no
+// update_agent.
+type AgentCommand struct {
+	ID             uuid.UUID
+	DeviceID       uuid.UUID
+	Type           AgentCommandType
+	BackupPlanID   *uuid.UUID
+	AgentVersion   string
+	Status         AgentCommandStatus
+	Result         string
+	RequestedBy    *uuid.UUID
+	ExpiresAt      time.Time
+	AcknowledgedAt *time.Time
+	CompletedAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (c *AgentCommand) Validate() error {
+	switch c.Type {
+	case AgentCommandRunBackup, AgentCommandVerify, AgentCommandRestoreTest:
+		if c.BackupPlanID == nil {
+			return ErrInvalidAgentCommand.WithDetails(map[string]string{"backup_plan_id": "agent_command.plan_required"})
+		}
+		if c.AgentVersion != "" {
+			return ErrInvalidAgentCommand.WithDetails(map[string]string{"agent_version": "agent_command.version_not_allowed"})
+		}
+	case AgentCommandUpdateAgent:
+		if c.AgentVersion == "" {
+			return ErrInvalidAgentCommand.WithDetails(map[string]string{"agent_version": "agent_command.version_required"})
+		}
+		if c.BackupPlanID != nil {
+			return ErrInvalidAgentCommand.WithDetails(map[string]string{"backup_plan_id": "agent_command.plan_not_allowed"})
+		}
+	default:
+		return ErrInvalidAgentCommand.WithDetails(map[string]string{"type": "agent_command.invalid_type"})
+	}
+	return nil
+}
+
+// Acknowledge é idempotente para que o agente possa repetir a confirmação.
+func (c *AgentCommand) Acknowledge(now time.Time) error {
+	switch c.Status {
+	case AgentCommandAcknowledged:
+		return nil
+	case AgentCommandPending:
+		if !now.Before(c.ExpiresAt) {
+			return ErrAgentCommandState
+		}
+		c.Status = AgentCommandAcknowledged
+		c.AcknowledgedAt = &now
+		return nil
+	default:
+		return ErrAgentCommandState
+	}
+}
+
+// Complete aceita o resultado de um comando pendente não expirado, para o
+// agente que executa sem confirmar antes.
+func (c *AgentCommand) Complete(success bool, result string, now time.Time) error {
+	if c.Status == AgentCommandPending {
+		if err := c.Acknowledge(now); err != nil {
+			return err
+		}
+	}
+
+	if c.Status != AgentCommandAcknowledged {
+		return ErrAgentCommandState
+	}
+
+	c.Status = AgentCommandFailed
+	if success {
+		c.Status = AgentCommandSucceeded
+	}
+	c.Result = result
+	c.CompletedAt = &now
+	return nil
+}
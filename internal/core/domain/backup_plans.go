@@ -2,28 +2,178 @@ package domain
 
 import (
 	"math/big"
+	"path"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type BackupType string
+
+const (
+	BackupTypeFull         BackupType = "full"
+	BackupTypeIncremental  BackupType = "incremental"
+	BackupTypeDifferential BackupType = "differential"
+)
+
+type BackupSourceKind string
+
+const (
+	BackupSourcePath     BackupSourceKind = "path"
+	BackupSourceVolume   BackupSourceKind = "volume"
+	BackupSourceDatabase BackupSourceKind = "database"
+)
+
 type BackupPlan struct {
 	ID              uuid.UUID
 	Name            string
 	BackupSizeBytes *big.Int
 	DeviceID        uuid.UUID
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
-	Customer        *Customer
-	Device          *Device
-	WeekDays        []BackupPlanWeekDay
+	// RetentionPolicyID é opcional; a política precisa ser do mesmo cliente
+	// do dispositivo.
+	RetentionPolicyID *uuid.UUID
+	// StorageTargetID é o destino onde os backups são gravados; precisa ser
+	// do mesmo cliente ou compartilhado.
+	StorageTargetID *uuid.UUID
+	// Compression e Encryption nil mantêm o valor atual na alteração.
+	Compression *bool
+	Encryption  *bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Customer    *Customer
+	Device      *Device
+	WeekDays    []BackupPlanWeekDay
+	Sources     []BackupPlanSource
+	// Verification é nil quando o plano não tem agenda de verificação.
+	Verification *VerificationStatus
+}
+
+// BackupPlanDetach indica, na alteração, os vínculos opcionais removidos
+// explicitamente; campos nil no plano mantêm o valor atual.
+type BackupPlanDetach struct {
+	RetentionPolicy bool
+	StorageTarget   bool
+}
+
+// NormalizeWeekDay devolve o dia com a grafia de time.Weekday.String, que é a
+// usada por NextRun e pelo enum do banco, ignorando maiúsculas e espaços.
+// Dias desconhecidos voltam como vieram, com false.
+func NormalizeWeekDay(day string) (string, bool) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(strings.TrimSpace(day), weekday.String()) {
+			return weekday.String(), true
+		}
+	}
+
+	return day, false
 }
 
 type BackupPlanWeekDay struct {
 	ID           uuid.UUID
 	Day          string
 	TimeDay      time.Time
+	BackupType   BackupType
+	BackupPlanID uuid.UUID
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// BackupPlanSource é o que o agente copia: um caminho, um volume ou um banco
+// de dados. Include e Exclude são globs no formato de path.Match, relativos
+// a Location.
+type BackupPlanSource struct {
+	ID           uuid.UUID
 	BackupPlanID uuid.UUID
+	Kind         BackupSourceKind
+	Location     string
+	Include      []string
+	Exclude      []string
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
+
+// Validate exige ao menos um backup completo na semana, já que incrementais e
+// diferenciais dependem dele para serem restaurados, e dias na grafia de
+// NormalizeWeekDay.
+func (bp *BackupPlan) Validate() error {
+	hasFull := false
+	for _, day := range bp.WeekDays {
+		if normalized, ok := NormalizeWeekDay(day.Day); !ok || normalized != day.Day {
+			return ErrValidation.WithDetails(map[string]string{"week_days": "backup_plan.invalid_day"})
+		}
+
+		switch day.BackupType {
+		case BackupTypeFull:
+			hasFull = true
+		case BackupTypeIncremental, BackupTypeDifferential:
+		default:
+			return ErrInvalidBackupType.WithDetails(map[string]string{"week_days": "backup_plan.invalid_backup_type"})
+		}
+	}
+
+	if !hasFull {
+		return ErrNoFullBackup.WithDetails(map[string]string{"week_days": "backup_plan.no_full_backup"})
+	}
+
+	for _, source := range bp.Sources {
+		if err := source.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *BackupPlanSource) Validate() error {
+	switch s.Kind {
+	case BackupSourcePath, BackupSourceVolume, BackupSourceDatabase:
+	default:
+		return ErrInvalidBackupSource
+	}
+
+	if strings.TrimSpace(s.Location) == "" {
+		return ErrInvalidBackupSource
+	}
+
+	for _, pattern := range slices.Concat(s.Include, s.Exclude) {
+		if strings.TrimSpace(pattern) == "" {
+			return ErrInvalidBackupSource
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return ErrInvalidBackupSource
+		}
+	}
+
+	return nil
+}
+
+// NextRun devolve o primeiro horário agendado do plano a partir de after
+// (inclusive), no fuso de after. Sem horários válidos, devolve false.
+func (bp *BackupPlan) NextRun(after time.Time) (time.Time, bool) {
+	var next time.Time
+	for offset := 0; offset <= 7; offset++ {
+		date := after.AddDate(0, 0, offset)
+		for _, day := range bp.WeekDays {
+			if day.Day != date.Weekday().String() {
+				continue
+			}
+
+			run := time.Date(date.Year(), date.Month(), date.Day(), day.TimeDay.Hour(), day.TimeDay.Minute(), 0, 0, after.Location())
+			if run.Before(after) {
+				continue
+			}
+
+			if next.IsZero() || run.Before(next) {
+				next = run
+			}
+		}
+
+		if !next.IsZero() {
+			return next, true
+		}
+	}
+
+	return next, false
+}
@@ -1,23 +1,126 @@
 package domain
 
-import "errors"
+import (
+	"net/http"
+	"time"
+)
+
+// Error é o erro de domínio exposto pela API. Code é estável e pode ser usado
+// pelos clientes; Message é segura para exibição; a causa original fica
+// disponível apenas para logs via errors.Unwrap.
+type Error struct {
+	Code    string
+	Status  int
+	Message string
+	Details any
+	// RetryAfter é repassado ao cliente no cabeçalho Retry-After.
+	RetryAfter time.Duration
+	cause      error
+}
+
+func newError(code string, status int, message string) *Error {
+	return &Error{
+		Code:    code,
+		Status:  status,
+		Message: message,
+	}
+}
+
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return e.Code + ": " + e.cause.Error()
+	}
+	return e.Code
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is considera iguais erros com o mesmo código, de modo que cópias criadas
+// por Wrap e WithDetails continuam reconhecidas por errors.Is.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+func (e *Error) Wrap(cause error) *Error {
+	err := *e
+	err.cause = cause
+	return &err
+}
+
+func (e *Error) WithDetails(details any) *Error {
+	err := *e
+	err.Details = details
+	return &err
+}
+
+func (e *Error) WithRetryAfter(retryAfter time.Duration) *Error {
+	err := *e
+	err.RetryAfter = retryAfter
+	return &err
+}
 
 var (
-	ErrBadRequest                  = errors.New("ERR_BAD_REQUEST")
-	ErrServiceUnavailable          = errors.New("ERR_SERVICE_UNAVAILABLE")
-	ErrForbidden                   = errors.New("ERR_FORBIDDEN")
-	ErrInternal                    = errors.New("ERR_INTERNAL_ERROR")
-	ErrDataNotFound                = errors.New("ERR_DATA_NOT_FOUND")
-	ErrConflictingData             = errors.New("ERR_CONFLICTING_DATA")
-	ErrInvalidCredentials          = errors.New("ERR_INVALID_CREDENTIALS")
-	ErrUnauthorized                = errors.New("ERR_UNAUTHORIZED")
-	ErrTokenRequired               = errors.New("ERR_TOKEN_REQUIRED")
-	ErrTokenCreation               = errors.New("ERR_TOKEN_CREATION_ERROR")
-	ErrTokenDuration               = errors.New("ERR_TOKEN_DURATION_ERROR")
-	ErrExpiredToken                = errors.New("ERR_EXPIRED_TOKEN")
-	ErrInvalidToken                = errors.New("ERR_INVALID_TOKEN")
-	ErrEmptyAuthorizationHeader    = errors.New("ERR_EMPTY_AUTH_HEADER")
-	ErrInvalidAuthorizationHeader  = errors.New("ERR_INVALID_AUTH_HEADER")
-	ErrInvalidAuthorizationType    = errors.New("ERR_INVALID_AUTH_TYPE")
-	ErrInvalidAuthorizationPayload = errors.New("ERR_INVALID_AUTH_PAYLOAD")
+	ErrBadRequest                  = newError("ERR_BAD_REQUEST", http.StatusBadRequest, "Requisição inválida")
+	ErrInvalidJSON                 = newError("ERR_INVALID_JSON", http.StatusBadRequest, "JSON inválido")
+	ErrInvalidUUID                 = newError("ERR_INVALID_UUID", http.StatusBadRequest, "UUID inválido")
+	ErrInvalidPagination           = newError("ERR_INVALID_PAGINATION", http.StatusBadRequest, "Page e limit são obrigatórios e devem ser números válidos")
+	ErrValidation                  = newError("ERR_VALIDATION", http.StatusBadRequest, "Dados de entrada inválidos")
+	ErrServiceUnavailable          = newError("ERR_SERVICE_UNAVAILABLE", http.StatusServiceUnavailable, "Serviço indisponível")
+	ErrForbidden                   = newError("ERR_FORBIDDEN", http.StatusForbidden, "Acesso negado")
+	ErrInternal                    = newError("ERR_INTERNAL_ERROR", http.StatusInternalServerError, "Erro interno do servidor")
+	ErrDataNotFound                = newError("ERR_DATA_NOT_FOUND", http.StatusNotFound, "Recurso não encontrado")
+	ErrConflictingData             = newError("ERR_CONFLICTING_DATA", http.StatusConflict, "Conflito de dados")
+	ErrInvalidCredentials          = newError("ERR_INVALID_CREDENTIALS", http.StatusUnauthorized, "Usuário ou senha inválidos")
+	ErrUnauthorized                = newError("ERR_UNAUTHORIZED", http.StatusUnauthorized, "Falha na autenticação")
+	ErrTokenRequired               = newError("ERR_TOKEN_REQUIRED", http.StatusInternalServerError, "Chave de assinatura do token não configurada")
+	ErrTokenCreation               = newError("ERR_TOKEN_CREATION_ERROR", http.StatusInternalServerError, "Falha ao gerar o token")
+	ErrTokenDuration               = newError("ERR_TOKEN_DURATION_ERROR", http.StatusInternalServerError, "Duração do token inválida")
+	ErrExpiredToken                = newError("ERR_EXPIRED_TOKEN", http.StatusUnauthorized, "Token expirado")
+	ErrInvalidToken                = newError("ERR_INVALID_TOKEN", http.StatusUnauthorized, "Token inválido")
+	ErrEmptyAuthorizationHeader    = newError("ERR_EMPTY_AUTH_HEADER", http.StatusUnauthorized, "Cabeçalho de autorização ausente")
+	ErrInvalidAuthorizationHeader  = newError("ERR_INVALID_AUTH_HEADER", http.StatusUnauthorized, "Cabeçalho de autorização inválido")
+	ErrInvalidAuthorizationType    = newError("ERR_INVALID_AUTH_TYPE", http.StatusUnauthorized, "Tipo de autorização não suportado")
+	ErrInvalidAuthorizationPayload = newError("ERR_INVALID_AUTH_PAYLOAD", http.StatusUnauthorized, "Dados de autenticação inválidos")
+	ErrInvalidAPIKey               = newError("ERR_INVALID_API_KEY", http.StatusUnauthorized, "Chave de API inválida, expirada ou revogada")
+	ErrInsufficientScope           = newError("ERR_INSUFFICIENT_SCOPE", http.StatusForbidden, "A chave de API não tem o escopo necessário")
+	ErrSessionRevoked              = newError("ERR_SESSION_REVOKED", http.StatusUnauthorized, "Sessão encerrada, faça login novamente")
+	ErrTooManyRequests             = newError("ERR_TOO_MANY_REQUESTS", http.StatusTooManyRequests, "Muitas tentativas, tente novamente mais tarde")
+	ErrAccountLocked               = newError("ERR_ACCOUNT_LOCKED", http.StatusLocked, "Conta bloqueada temporariamente por excesso de tentativas")
+	ErrMFARequired                 = newError("ERR_MFA_REQUIRED", http.StatusForbidden, "Autenticação em dois fatores obrigatória para este perfil")
+	ErrInvalidMFACode              = newError("ERR_INVALID_MFA_CODE", http.StatusUnauthorized, "Código de verificação inválido")
+	ErrMFANotEnrolled              = newError("ERR_MFA_NOT_ENROLLED", http.StatusConflict, "Autenticação em dois fatores não cadastrada")
+	ErrInvalidUserToken            = newError("ERR_INVALID_USER_TOKEN", http.StatusBadRequest, "Link inválido ou expirado")
+	ErrEmailNotVerified            = newError("ERR_EMAIL_NOT_VERIFIED", http.StatusForbidden, "E-mail ainda não confirmado")
+	ErrWeakPassword                = newError("ERR_WEAK_PASSWORD", http.StatusBadRequest, "A senha não atende à política de senhas")
+	ErrPasswordBreached            = newError("ERR_PASSWORD_BREACHED", http.StatusBadRequest, "A senha aparece em vazamentos conhecidos, escolha outra")
+	ErrPasswordReused              = newError("ERR_PASSWORD_REUSED", http.StatusBadRequest, "A senha já foi usada recentemente, escolha outra")
+	ErrInvalidCurrentPassword      = newError("ERR_INVALID_CURRENT_PASSWORD", http.StatusBadRequest, "Senha atual incorreta")
+	ErrPasswordChangeRequired      = newError("ERR_PASSWORD_CHANGE_REQUIRED", http.StatusForbidden, "É necessário trocar a senha antes de continuar")
+	ErrInvalidOIDCState            = newError("ERR_INVALID_OIDC_STATE", http.StatusBadRequest, "Login único inválido ou expirado, tente novamente")
+	ErrOIDCProvider                = newError("ERR_OIDC_PROVIDER", http.StatusBadGateway, "Falha ao comunicar com o provedor de identidade")
+	ErrSSONotProvisioned           = newError("ERR_SSO_NOT_PROVISIONED", http.StatusForbidden, "Usuário do provedor de identidade não cadastrado")
+	ErrSSOLinkRequired             = newError("ERR_SSO_LINK_REQUIRED", http.StatusForbidden, "Conta administrativa: entre com a senha e vincule o provedor de identidade antes de usar o login único")
+	ErrInvalidRetentionPolicy      = newError("ERR_INVALID_RETENTION_POLICY", http.StatusBadRequest, "A política de retenção precisa de ao menos uma regra e não aceita valores negativos")
+	ErrRetentionPolicyCustomer     = newError("ERR_RETENTION_CUSTOMER", http.StatusBadRequest, "A política de retenção pertence a outro cliente")
+	ErrNoRetentionPolicy           = newError("ERR_NO_RETENTION_POLICY", http.StatusConflict, "O plano de backup não tem política de retenção")
+	ErrInvalidStorageTarget        = newError("ERR_INVALID_STORAGE_TARGET", http.StatusBadRequest, "Destino de armazenamento inválido: informe tipo, endereço e capacidade maior que zero")
+	ErrStorageTargetCustomer       = newError("ERR_STORAGE_TARGET_CUSTOMER", http.StatusBadRequest, "O destino de armazenamento pertence a outro cliente")
+	ErrCapacityExceeded            = newError("ERR_CAPACITY_EXCEEDED", http.StatusConflict, "O plano ultrapassa a capacidade projetada do destino de armazenamento")
+	ErrSecretsNotConfigured        = newError("ERR_SECRETS_NOT_CONFIGURED", http.StatusServiceUnavailable, "Chave mestra de segredos não configurada")
+	ErrNoFullBackup                = newError("ERR_NO_FULL_BACKUP", http.StatusBadRequest, "O plano precisa de ao menos um backup completo")
+	ErrInvalidBackupType           = newError("ERR_INVALID_BACKUP_TYPE", http.StatusBadRequest, "Tipo de backup inválido: use full, incremental ou differential")
+	ErrInvalidBackupSource         = newError("ERR_INVALID_BACKUP_SOURCE", http.StatusBadRequest, "Origem de backup inválida: informe tipo, local e padrões de inclusão e exclusão válidos")
+	ErrInvalidAgentToken           = newError("ERR_INVALID_AGENT_TOKEN", http.StatusUnauthorized, "Token do agente inválido ou revogado")
+	ErrInvalidAgentCommand         = newError("ERR_INVALID_AGENT_COMMAND", http.StatusBadRequest, "Comando inválido")
+	ErrAgentCommandState           = newError("ERR_AGENT_COMMAND_STATE", http.StatusConflict, "O comando já foi concluído ou expirou")
+	ErrRestoreRequestState         = newError("ERR_RESTORE_REQUEST_STATE", http.StatusConflict, "O pedido de restauração não permite essa operação no estado atual")
+	ErrSelfApproval                = newError("ERR_SELF_APPROVAL", http.StatusForbidden, "Quem solicitou a restauração não pode aprová-la ou rejeitá-la")
+	ErrRestoreTargetDevice         = newError("ERR_RESTORE_TARGET_DEVICE", http.StatusBadRequest, "O dispositivo de destino precisa ser do mesmo cliente do plano")
+	ErrInvalidRestorePoint         = newError("ERR_INVALID_RESTORE_POINT", http.StatusBadRequest, "Ponto de restauração inválido")
+	ErrInvalidVerificationSchedule = newError("ERR_INVALID_VERIFICATION", http.StatusBadRequest, "Agenda de verificação inválida")
+	ErrVerificationJobState        = newError("ERR_VERIFICATION_JOB_STATE", http.StatusConflict, "A verificação já foi concluída")
+	ErrMFAAlreadyEnabled           = newError("ERR_MFA_ALREADY_ENABLED", http.StatusConflict, "Autenticação em dois fatores já está ativa")
 )
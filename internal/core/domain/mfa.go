@@ -0,0 +1,17 @@
+package domain
+
+// TOTPEnrollment é devolvido uma única vez no cadastro do segundo fator; os
+// códigos de recuperação ficam armazenados apenas como hash.
+type TOTPEnrollment struct {
+	Secret        string
+	URI           string
+	RecoveryCodes []string
+}
+
+// LoginResult traz o token de acesso ou, quando a conta usa MFA, o token de
+// desafio que deve ser trocado junto com o código em /auth/mfa/verify.
+type LoginResult struct {
+	AccessToken    string
+	MFARequired    bool
+	ChallengeToken string
+}
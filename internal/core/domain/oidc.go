@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OIDCIdentity reúne as claims do ID token usadas para vincular ou criar o
+// usuário local.
+type OIDCIdentity struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+	Groups            []string
+	// MFA indica que o provedor informou autenticação multifator em amr.
+	MFA bool
+}
+
+// OIDCState guarda, entre o redirecionamento e o callback, o verificador
+// PKCE e o nonce do login iniciado. O state em si só é armazenado como hash.
+// UserID é preenchido quando um usuário já autenticado pede o vínculo da
+// própria conta com o provedor.
+type OIDCState struct {
+	StateHash    string
+	CodeVerifier string
+	Nonce        string
+	UserID       *uuid.UUID
+	ExpiresAt    time.Time
+}
+
+// OIDCLogin é o início do login: a URL do provedor e o state que o
+// navegador precisa apresentar de volta no callback.
+type OIDCLogin struct {
+	AuthURL   string
+	State     string
+	ExpiresAt time.Time
+}
+
+type OIDCPolicy struct {
+	StateTTL                 time.Duration
+	AutoProvision            bool
+	DefaultRole              UserRole
+	RequireEmailVerification bool
+	// RoleMapping associa grupos do provedor a papéis locais. Quando
+	// configurado, o papel é sincronizado a cada login.
+	RoleMapping map[string]UserRole
+}
+
+// RoleFor devolve o papel de maior privilégio entre os grupos mapeados ou o
+// papel padrão quando nenhum grupo corresponde.
+func (p OIDCPolicy) RoleFor(groups []string) UserRole {
+	role := p.DefaultRole
+	for _, group := range groups {
+		mapped, ok := p.RoleMapping[group]
+		if !ok {
+			continue
+		}
+		if mapped == Admin {
+			return Admin
+		}
+		role = mapped
+	}
+	return role
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+type PasswordPolicy struct {
+	MinLength     int
+	MaxLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// HistorySize é a quantidade de senhas anteriores que não podem ser reutilizadas.
+	HistorySize int
+}
+
+// Violations devolve as chaves de tradução das regras de composição que a
+// senha não atende. O tamanho é contado em caracteres, não em bytes.
+func (pp PasswordPolicy) Violations(password string) []string {
+	var violations []string
+
+	length := utf8.RuneCountInString(password)
+	if length < pp.MinLength {
+		violations = append(violations, "password.min_length")
+	}
+	if pp.MaxLength > 0 && length > pp.MaxLength {
+		violations = append(violations, "password.max_length")
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+
+	if pp.RequireUpper && !upper {
+		violations = append(violations, "password.upper")
+	}
+	if pp.RequireLower && !lower {
+		violations = append(violations, "password.lower")
+	}
+	if pp.RequireDigit && !digit {
+		violations = append(violations, "password.digit")
+	}
+	if pp.RequireSymbol && !symbol {
+		violations = append(violations, "password.symbol")
+	}
+
+	return violations
+}
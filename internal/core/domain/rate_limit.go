@@ -0,0 +1,51 @@
+package domain
+
+import "time"
+
+// RateLimit descreve um token bucket: até Burst requisições seguidas e um
+// novo token a cada Refill.
+type RateLimit struct {
+	Burst  int
+	Refill time.Duration
+}
+
+type LoginPolicy struct {
+	UsernameLimit       RateLimit
+	LockoutThreshold    int
+	LockoutBaseDuration time.Duration
+	LockoutMaxDuration  time.Duration
+	// RequireEmailVerification impede o login enquanto o e-mail não for confirmado.
+	RequireEmailVerification bool
+}
+
+// LockoutDuration dobra a cada falha acima do limite, respeitando o máximo.
+func (lp LoginPolicy) LockoutDuration(failedAttempts int) time.Duration {
+	if lp.LockoutThreshold <= 0 || failedAttempts < lp.LockoutThreshold {
+		return 0
+	}
+
+	duration := lp.LockoutBaseDuration
+	for i := lp.LockoutThreshold; i < failedAttempts; i++ {
+		duration *= 2
+		if duration >= lp.LockoutMaxDuration {
+			return lp.LockoutMaxDuration
+		}
+	}
+	return min(duration, lp.LockoutMaxDuration)
+}
+
+// Take reabastece o bucket pelo tempo decorrido e tenta consumir um token.
+// Devolve o saldo atualizado e, quando negado, o tempo até o próximo token.
+func (rl RateLimit) Take(tokens float64, elapsed time.Duration) (float64, bool, time.Duration) {
+	if rl.Refill > 0 && elapsed > 0 {
+		tokens += float64(elapsed) / float64(rl.Refill)
+	}
+	tokens = min(tokens, float64(rl.Burst))
+
+	if tokens >= 1 {
+		return tokens - 1, true, 0
+	}
+
+	retryAfter := time.Duration((1 - tokens) * float64(rl.Refill))
+	return tokens, false, retryAfter
+}
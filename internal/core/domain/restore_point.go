@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RestorePoint é um backup concluído a partir do qual é possível restaurar.
+// O ID é gerado pelo agente, para que reenviar o mesmo relatório não duplique
+// o ponto. ExpiresAt vem da política de retenção do plano; pontos vencidos
+// podem ser apagados do destino pelo agente.
+type RestorePoint struct {
+	ID           uuid.UUID
+	BackupPlanID uuid.UUID
+	Type         BackupType
+	SizeBytes    *big.Int
+	Checksum     string
+	Location     string
+	CreatedAt    time.Time
+	ExpiresAt    *time.Time
+	ReportedAt   time.Time
+}
+
+func (p *RestorePoint) Validate() error {
+	switch p.Type {
+	case BackupTypeFull, BackupTypeIncremental, BackupTypeDifferential:
+	default:
+		return ErrInvalidRestorePoint.WithDetails(map[string]string{"type": "restore_point.invalid_type"})
+	}
+
+	// O tamanho é gravado em bigint; valores fora de int64 seriam truncados.
+	if p.SizeBytes == nil || p.SizeBytes.Sign() < 0 || !p.SizeBytes.IsInt64() {
+		return ErrInvalidRestorePoint.WithDetails(map[string]string{"size_bytes": "restore_point.invalid_size"})
+	}
+
+	if p.Location == "" {
+		return ErrInvalidRestorePoint.WithDetails(map[string]string{"location": "restore_point.location_required"})
+	}
+
+	if p.CreatedAt.IsZero() {
+		return ErrInvalidRestorePoint.WithDetails(map[string]string{"created_at": "restore_point.created_at_required"})
+	}
+	return nil
+}
+
+func (p *RestorePoint) Expired(now time.Time) bool {
+	return p.ExpiresAt != nil && !p.ExpiresAt.After(now)
+}
+
+// Expire aplica a política aos pontos ainda válidos e devolve os que mudaram
+// de validade. Pontos descartados vencem em now; os mantidos vencem ao passar
+// de MaxAgeDays, contado a partir do ponto mais recente que dependa deles, ou
+// ficam sem validade quando a política não tem esse limite. Um ponto vencido
+// nunca volta a ser válido.
+func (p *RetentionPolicy) Expire(points []RestorePoint, now time.Time) []RestorePoint {
+	var changed []RestorePoint
+	for _, decision := range p.Apply(points, now) {
+		point := decision.RestorePoint
+
+		var expiresAt *time.Time
+		switch {
+		case !decision.Keep:
+			expiresAt = &now
+		case p.MaxAgeDays > 0:
+			base := point.CreatedAt
+			if decision.ChainUntil.After(base) {
+				base = decision.ChainUntil
+			}
+			maxAge := base.AddDate(0, 0, p.MaxAgeDays)
+			expiresAt = &maxAge
+		}
+
+		if sameTime(point.ExpiresAt, expiresAt) {
+			continue
+		}
+
+		point.ExpiresAt = expiresAt
+		changed = append(changed, point)
+	}
+
+	return changed
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
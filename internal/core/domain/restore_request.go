@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RestoreRequestStatus segue requested -> approved -> in_progress ->
+// completed/failed. Um pedido ainda não aprovado pode ser rejeitado.
+type RestoreRequestStatus string
+
+const (
+	RestoreRequested  RestoreRequestStatus = "requested"
+	RestoreApproved   RestoreRequestStatus = "approved"
+	RestoreRejected   RestoreRequestStatus = "rejected"
+	RestoreInProgress RestoreRequestStatus = "in_progress"
+	RestoreCompleted  RestoreRequestStatus = "completed"
+	RestoreFailed     RestoreRequestStatus = "failed"
+)
+
+// RestoreRequest é o pedido de restauração de um ponto do plano. Sem
+// TargetDeviceID a restauração vai para o próprio dispositivo do plano.
+type RestoreRequest struct {
+	ID             uuid.UUID
+	BackupPlanID   uuid.UUID
+	RestorePointID uuid.UUID
+	TargetDeviceID uuid.UUID
+	TargetPath     string
+	Reason         string
+	Status         RestoreRequestStatus
+	RequestedBy    *uuid.UUID
+	// ReviewedBy é quem aprovou ou rejeitou o pedido; ReviewNote é o motivo
+	// da rejeição ou a observação da aprovação.
+	ReviewedBy  *uuid.UUID
+	ReviewNote  string
+	ReviewedAt  *time.Time
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	Result      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Review aprova ou rejeita o pedido. Quem pediu não pode revisar o próprio
+// pedido.
+func (r *RestoreRequest) Review(reviewerID uuid.UUID, approve bool, note string, now time.Time) error {
+	if r.Status != RestoreRequested {
+		return ErrRestoreRequestState
+	}
+
+	if r.RequestedBy != nil && *r.RequestedBy == reviewerID {
+		return ErrSelfApproval
+	}
+
+	r.Status = RestoreRejected
+	if approve {
+		r.Status = RestoreApproved
+	}
+	r.ReviewedBy = &reviewerID
+	r.ReviewNote = note
+	r.ReviewedAt = &now
+	return nil
+}
+
+func (r *RestoreRequest) Start(now time.Time) error {
+	if r.Status != RestoreApproved {
+		return ErrRestoreRequestState
+	}
+
+	r.Status = RestoreInProgress
+	r.StartedAt = &now
+	return nil
+}
+
+func (r *RestoreRequest) Complete(success bool, result string, now time.Time) error {
+	if r.Status != RestoreInProgress {
+		return ErrRestoreRequestState
+	}
+
+	r.Status = RestoreFailed
+	if success {
+		r.Status = RestoreCompleted
+	}
+	r.Result = result
+	r.CompletedAt = &now
+	return nil
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Motivos pelos quais um ponto de restauração é mantido.
+const (
+	RetentionReasonLatest  = "latest"
+	RetentionReasonLast    = "last"
+	RetentionReasonDaily   = "daily"
+	RetentionReasonWeekly  = "weekly"
+	RetentionReasonMonthly = "monthly"
+	RetentionReasonYearly  = "yearly"
+	RetentionReasonMaxAge  = "max_age"
+	RetentionReasonChain   = "chain"
+)
+
+// RetentionPolicy define quantos pontos de restauração manter. As regras se
+// somam: um ponto é mantido se qualquer uma delas o mantiver. MaxAgeDays é um
+// teto; sozinha, mantém tudo o que estiver dentro do período.
+type RetentionPolicy struct {
+	ID          uuid.UUID
+	CustomerID  uuid.UUID
+	Name        string
+	KeepLast    int
+	KeepDaily   int
+	KeepWeekly  int
+	KeepMonthly int
+	KeepYearly  int
+	MaxAgeDays  int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type RetentionDecision struct {
+	RestorePoint RestorePoint
+	Keep         bool
+	Reasons      []string
+	// ChainUntil é a data do ponto mantido mais recente que depende deste
+	// para ser restaurado; zero quando nenhum depende.
+	ChainUntil time.Time
+}
+
+func (p *RetentionPolicy) Validate() error {
+	counts := []int{p.KeepLast, p.KeepDaily, p.KeepWeekly, p.KeepMonthly, p.KeepYearly, p.MaxAgeDays}
+
+	total := 0
+	for _, count := range counts {
+		if count < 0 {
+			return ErrInvalidRetentionPolicy
+		}
+		total += count
+	}
+
+	if total == 0 {
+		return ErrInvalidRetentionPolicy
+	}
+
+	return nil
+}
+
+func (p *RetentionPolicy) hasCountRules() bool {
+	return p.KeepLast+p.KeepDaily+p.KeepWeekly+p.KeepMonthly+p.KeepYearly > 0
+}
+
+// Apply decide, do mais recente para o mais antigo, quais pontos manter. Em
+// cada período do GFS fica o ponto mais recente, e o ponto mais recente de
+// todos nunca é descartado, para que o plano não fique sem restauração.
+// Incrementais e diferenciais mantidos levam junto a cadeia de que dependem:
+// o incremental, todos os pontos até o completo anterior; o diferencial, só
+// esse completo. A cadeia é mantida mesmo além de MaxAgeDays.
+func (p *RetentionPolicy) Apply(points []RestorePoint, now time.Time) []RetentionDecision {
+	sorted := make([]RestorePoint, len(points))
+	copy(sorted, points)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.After(sorted[j].CreatedAt)
+	})
+
+	buckets := []struct {
+		reason string
+		limit  int
+		key    func(time.Time) string
+		seen   map[string]bool
+	}{
+		{RetentionReasonDaily, p.KeepDaily, func(t time.Time) string { return t.Format("2006-01-02") }, map[string]bool{}},
+		{RetentionReasonWeekly, p.KeepWeekly, func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}, map[string]bool{}},
+		{RetentionReasonMonthly, p.KeepMonthly, func(t time.Time) string { return t.Format("2006-01") }, map[string]bool{}},
+		{RetentionReasonYearly, p.KeepYearly, func(t time.Time) string { return t.Format("2006") }, map[string]bool{}},
+	}
+
+	var cutoff time.Time
+	if p.MaxAgeDays > 0 {
+		cutoff = now.AddDate(0, 0, -p.MaxAgeDays)
+	}
+
+	// needAll e needFull indicam que um ponto mais recente mantido depende
+	// dos anteriores; chainUntil é a data do mais recente deles.
+	var needAll, needFull bool
+	var chainUntil time.Time
+
+	decisions := make([]RetentionDecision, 0, len(sorted))
+	for i, point := range sorted {
+		var reasons []string
+
+		if i < p.KeepLast {
+			reasons = append(reasons, RetentionReasonLast)
+		}
+
+		for b := range buckets {
+			bucket := &buckets[b]
+			if bucket.limit == 0 {
+				continue
+			}
+			key := bucket.key(point.CreatedAt)
+			if bucket.seen[key] || len(bucket.seen) >= bucket.limit {
+				continue
+			}
+			bucket.seen[key] = true
+			reasons = append(reasons, bucket.reason)
+		}
+
+		if !cutoff.IsZero() {
+			expired := point.CreatedAt.Before(cutoff)
+			if expired {
+				reasons = nil
+			} else if !p.hasCountRules() {
+				reasons = append(reasons, RetentionReasonMaxAge)
+			}
+		}
+
+		if i == 0 && len(reasons) == 0 {
+			reasons = append(reasons, RetentionReasonLatest)
+		}
+
+		isFull := point.Type != BackupTypeIncremental && point.Type != BackupTypeDifferential
+		if len(reasons) == 0 && (needAll || needFull && isFull) {
+			reasons = append(reasons, RetentionReasonChain)
+		}
+
+		decision := RetentionDecision{
+			RestorePoint: point,
+			Keep:         len(reasons) > 0,
+			Reasons:      reasons,
+		}
+		if needAll || needFull && isFull {
+			decision.ChainUntil = chainUntil
+		}
+
+		switch {
+		case isFull && (needAll || needFull || decision.Keep):
+			needAll, needFull = false, false
+			chainUntil = time.Time{}
+		case decision.Keep:
+			if chainUntil.IsZero() {
+				chainUntil = point.CreatedAt
+			}
+			if point.Type == BackupTypeIncremental {
+				needAll = true
+			} else {
+				needFull = true
+			}
+		}
+
+		decisions = append(decisions, decision)
+	}
+
+	return decisions
+}
@@ -0,0 +1,314 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func retentionPoint(createdAt time.Time, backupType BackupType) RestorePoint {
+	return RestorePoint{
+		ID:        uuid.New(),
+		Type:      backupType,
+		CreatedAt: createdAt,
+	}
+}
+
+func at(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestRetentionPolicyApply(t *testing.T) {
+	now := at(2026, 3, 1, 12, 0)
+
+	tests := []struct {
+		name   string
+		policy RetentionPolicy
+		points []RestorePoint
+		// reasons são os motivos esperados por índice de points; nil quando
+		// o ponto é descartado.
+		reasons [][]string
+		// chainUntil é o ChainUntil esperado por índice; ausente é zero.
+		chainUntil map[int]time.Time
+	}{
+		{
+			name:   "últimos pontos",
+			policy: RetentionPolicy{KeepLast: 2},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 2, 26, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 2, 28, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 2, 27, 0, 0), BackupTypeFull),
+			},
+			reasons: [][]string{nil, {RetentionReasonLast}, {RetentionReasonLast}},
+		},
+		{
+			name:   "diário na virada do dia",
+			policy: RetentionPolicy{KeepDaily: 2},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 1, 2, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 1, 1, 23, 59), BackupTypeFull),
+				retentionPoint(at(2026, 1, 1, 8, 0), BackupTypeFull),
+				retentionPoint(at(2025, 12, 31, 23, 0), BackupTypeFull),
+			},
+			reasons: [][]string{{RetentionReasonDaily}, {RetentionReasonDaily}, nil, nil},
+		},
+		{
+			name:   "semanal na virada da semana ISO",
+			policy: RetentionPolicy{KeepWeekly: 2},
+			points: []RestorePoint{
+				// 04/01/2026 é domingo, o último dia da semana ISO 1.
+				retentionPoint(at(2026, 1, 5, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 1, 4, 23, 59), BackupTypeFull),
+				retentionPoint(at(2026, 1, 3, 10, 0), BackupTypeFull),
+			},
+			reasons: [][]string{{RetentionReasonWeekly}, {RetentionReasonWeekly}, nil},
+		},
+		{
+			name:   "mensal na virada do mês",
+			policy: RetentionPolicy{KeepMonthly: 2},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 2, 1, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 1, 31, 23, 59), BackupTypeFull),
+				retentionPoint(at(2026, 1, 15, 0, 0), BackupTypeFull),
+			},
+			reasons: [][]string{{RetentionReasonMonthly}, {RetentionReasonMonthly}, nil},
+		},
+		{
+			name:   "anual na virada do ano",
+			policy: RetentionPolicy{KeepYearly: 2},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 1, 1, 0, 0), BackupTypeFull),
+				retentionPoint(at(2025, 12, 31, 23, 59), BackupTypeFull),
+				retentionPoint(at(2025, 6, 1, 0, 0), BackupTypeFull),
+			},
+			reasons: [][]string{{RetentionReasonYearly}, {RetentionReasonYearly}, nil},
+		},
+		{
+			name:   "regras somadas no mesmo ponto",
+			policy: RetentionPolicy{KeepLast: 1, KeepDaily: 2},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 2, 28, 10, 0), BackupTypeFull),
+				retentionPoint(at(2026, 2, 28, 8, 0), BackupTypeFull),
+				retentionPoint(at(2026, 2, 27, 8, 0), BackupTypeFull),
+			},
+			reasons: [][]string{{RetentionReasonLast, RetentionReasonDaily}, nil, {RetentionReasonDaily}},
+		},
+		{
+			name:   "idade máxima sozinha",
+			policy: RetentionPolicy{MaxAgeDays: 30},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 2, 1, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 1, 30, 12, 0), BackupTypeFull),
+				retentionPoint(at(2026, 1, 29, 0, 0), BackupTypeFull),
+			},
+			reasons: [][]string{{RetentionReasonMaxAge}, {RetentionReasonMaxAge}, nil},
+		},
+		{
+			name:   "idade máxima limita as regras de contagem",
+			policy: RetentionPolicy{KeepDaily: 10, MaxAgeDays: 5},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 2, 28, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 2, 20, 0, 0), BackupTypeFull),
+			},
+			reasons: [][]string{{RetentionReasonDaily}, nil},
+		},
+		{
+			name:   "mais recente mantido mesmo vencido",
+			policy: RetentionPolicy{MaxAgeDays: 5},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 1, 1, 0, 0), BackupTypeFull),
+				retentionPoint(at(2025, 12, 31, 0, 0), BackupTypeFull),
+			},
+			reasons: [][]string{{RetentionReasonLatest}, nil},
+		},
+		{
+			name:   "incremental mantém a cadeia até o completo",
+			policy: RetentionPolicy{KeepLast: 1},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 2, 1, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 2, 2, 0, 0), BackupTypeIncremental),
+				retentionPoint(at(2026, 2, 3, 0, 0), BackupTypeIncremental),
+				retentionPoint(at(2026, 2, 4, 0, 0), BackupTypeIncremental),
+				retentionPoint(at(2026, 1, 31, 0, 0), BackupTypeFull),
+			},
+			reasons: [][]string{{RetentionReasonChain}, {RetentionReasonChain}, {RetentionReasonChain}, {RetentionReasonLast}, nil},
+			chainUntil: map[int]time.Time{
+				0: at(2026, 2, 4, 0, 0),
+				1: at(2026, 2, 4, 0, 0),
+				2: at(2026, 2, 4, 0, 0),
+			},
+		},
+		{
+			name:   "diferencial mantém só o completo",
+			policy: RetentionPolicy{KeepLast: 1},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 2, 1, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 2, 2, 0, 0), BackupTypeDifferential),
+				retentionPoint(at(2026, 2, 3, 0, 0), BackupTypeDifferential),
+			},
+			reasons:    [][]string{{RetentionReasonChain}, nil, {RetentionReasonLast}},
+			chainUntil: map[int]time.Time{0: at(2026, 2, 3, 0, 0)},
+		},
+		{
+			name:   "cadeia termina no completo mais próximo",
+			policy: RetentionPolicy{KeepLast: 1},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 2, 1, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 2, 2, 0, 0), BackupTypeIncremental),
+				retentionPoint(at(2026, 2, 3, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 2, 4, 0, 0), BackupTypeIncremental),
+			},
+			reasons:    [][]string{nil, nil, {RetentionReasonChain}, {RetentionReasonLast}},
+			chainUntil: map[int]time.Time{2: at(2026, 2, 4, 0, 0)},
+		},
+		{
+			name:   "cadeia mantida além da idade máxima",
+			policy: RetentionPolicy{MaxAgeDays: 5},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 2, 20, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 2, 25, 0, 0), BackupTypeIncremental),
+				retentionPoint(at(2026, 2, 27, 0, 0), BackupTypeIncremental),
+			},
+			reasons: [][]string{{RetentionReasonChain}, {RetentionReasonMaxAge}, {RetentionReasonMaxAge}},
+			chainUntil: map[int]time.Time{
+				0: at(2026, 2, 27, 0, 0),
+				1: at(2026, 2, 27, 0, 0),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decisions := tt.policy.Apply(tt.points, now)
+			if len(decisions) != len(tt.points) {
+				t.Fatalf("len(decisions) = %d, want %d", len(decisions), len(tt.points))
+			}
+
+			for i, decision := range decisions {
+				if i > 0 && decision.RestorePoint.CreatedAt.After(decisions[i-1].RestorePoint.CreatedAt) {
+					t.Errorf("decisão %d fora da ordem do mais recente para o mais antigo", i)
+				}
+
+				index := slices.IndexFunc(tt.points, func(p RestorePoint) bool { return p.ID == decision.RestorePoint.ID })
+				want := tt.reasons[index]
+				if decision.Keep != (want != nil) {
+					t.Errorf("ponto %d: Keep = %v, want %v", index, decision.Keep, want != nil)
+				}
+				if !slices.Equal(decision.Reasons, want) {
+					t.Errorf("ponto %d: Reasons = %v, want %v", index, decision.Reasons, want)
+				}
+				if !decision.ChainUntil.Equal(tt.chainUntil[index]) {
+					t.Errorf("ponto %d: ChainUntil = %v, want %v", index, decision.ChainUntil, tt.chainUntil[index])
+				}
+			}
+		})
+	}
+}
+
+func TestRetentionPolicyExpire(t *testing.T) {
+	now := at(2026, 3, 1, 12, 0)
+	expiresAt := func(t time.Time) *time.Time { return &t }
+	withExpiry := func(point RestorePoint, expiresAt *time.Time) RestorePoint {
+		point.ExpiresAt = expiresAt
+		return point
+	}
+
+	tests := []struct {
+		name   string
+		policy RetentionPolicy
+		points []RestorePoint
+		// want é a validade esperada por índice de points, apenas para os
+		// pontos que mudaram.
+		want map[int]*time.Time
+	}{
+		{
+			name:   "descartados vencem agora",
+			policy: RetentionPolicy{KeepLast: 1},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 2, 28, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 2, 27, 0, 0), BackupTypeFull),
+			},
+			want: map[int]*time.Time{1: &now},
+		},
+		{
+			name:   "sem idade máxima os mantidos não vencem",
+			policy: RetentionPolicy{KeepLast: 2},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 2, 28, 0, 0), BackupTypeFull),
+				withExpiry(retentionPoint(at(2026, 2, 27, 0, 0), BackupTypeFull), expiresAt(at(2026, 3, 5, 0, 0))),
+			},
+			want: map[int]*time.Time{1: nil},
+		},
+		{
+			name:   "mantidos vencem na idade máxima",
+			policy: RetentionPolicy{MaxAgeDays: 30},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 2, 28, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 1, 29, 0, 0), BackupTypeFull),
+			},
+			want: map[int]*time.Time{
+				0: expiresAt(at(2026, 3, 30, 0, 0)),
+				1: &now,
+			},
+		},
+		{
+			name:   "validade já correta não muda",
+			policy: RetentionPolicy{MaxAgeDays: 30},
+			points: []RestorePoint{
+				withExpiry(retentionPoint(at(2026, 2, 28, 0, 0), BackupTypeFull), expiresAt(at(2026, 3, 30, 0, 0))),
+			},
+			want: map[int]*time.Time{},
+		},
+		{
+			name:   "cadeia vence com o dependente mais recente",
+			policy: RetentionPolicy{MaxAgeDays: 5},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 2, 20, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 2, 25, 0, 0), BackupTypeIncremental),
+				retentionPoint(at(2026, 2, 27, 0, 0), BackupTypeIncremental),
+			},
+			want: map[int]*time.Time{
+				0: expiresAt(at(2026, 3, 4, 0, 0)),
+				1: expiresAt(at(2026, 3, 4, 0, 0)),
+				2: expiresAt(at(2026, 3, 4, 0, 0)),
+			},
+		},
+		{
+			name:   "completo de diferencial vence com o diferencial",
+			policy: RetentionPolicy{KeepDaily: 1, MaxAgeDays: 10},
+			points: []RestorePoint{
+				retentionPoint(at(2026, 2, 25, 0, 0), BackupTypeFull),
+				retentionPoint(at(2026, 2, 26, 0, 0), BackupTypeDifferential),
+				retentionPoint(at(2026, 2, 28, 0, 0), BackupTypeDifferential),
+			},
+			want: map[int]*time.Time{
+				0: expiresAt(at(2026, 3, 10, 0, 0)),
+				1: &now,
+				2: expiresAt(at(2026, 3, 10, 0, 0)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed := tt.policy.Expire(tt.points, now)
+			if len(changed) != len(tt.want) {
+				t.Fatalf("len(changed) = %d, want %d", len(changed), len(tt.want))
+			}
+
+			for _, point := range changed {
+				index := slices.IndexFunc(tt.points, func(p RestorePoint) bool { return p.ID == point.ID })
+				want, ok := tt.want[index]
+				if !ok {
+					t.Errorf("ponto %d mudou sem esperar", index)
+					continue
+				}
+				if !sameTime(point.ExpiresAt, want) {
+					t.Errorf("ponto %d: ExpiresAt = %v, want %v", index, point.ExpiresAt, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Secret é um valor cifrado por envelope: DataKey é a chave própria do valor,
+// cifrada pela chave mestra KeyID, e Ciphertext é o valor cifrado por ela.
+// Nenhum dos campos revela o conteúdo sem a chave mestra.
+type Secret struct {
+	ID         uuid.UUID
+	KeyID      string
+	DataKey    []byte
+	Ciphertext []byte
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type SecretRotation struct {
+	KeyID   string
+	Rotated int
+	// Failed conta segredos cifrados por chaves que não estão mais configuradas.
+	Failed int
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session representa um token de acesso emitido no login; ID é o jti do
+// token, o que permite encerrar a sessão antes da expiração.
+type Session struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	IP         string
+	UserAgent  string
+	MFA        bool
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+}
+
+func (s *Session) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// IssuedToken é o token de acesso assinado junto com o jti e a expiração.
+type IssuedToken struct {
+	Token     string
+	ID        uuid.UUID
+	ExpiresAt time.Time
+}
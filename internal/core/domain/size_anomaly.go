@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SizeAnomalyKind string
+
+const (
+	SizeAnomalyGrowth    SizeAnomalyKind = "growth"
+	SizeAnomalyShrinkage SizeAnomalyKind = "shrinkage"
+	SizeAnomalyZeroBytes SizeAnomalyKind = "zero_bytes"
+)
+
+type SizeAlertSeverity string
+
+const (
+	SizeAlertWarning  SizeAlertSeverity = "warning"
+	SizeAlertCritical SizeAlertSeverity = "critical"
+)
+
+const (
+	// SizeBaselineWindow é a quantidade de backups anteriores do mesmo tipo
+	// usados na linha de base; abaixo de SizeBaselineMinSamples não há
+	// linha de base e só backups vazios são sinalizados.
+	SizeBaselineWindow     = 10
+	SizeBaselineMinSamples = 5
+
+	// Limites do desvio robusto (0,6745 * desvio / MAD) para alerta e
+	// alerta crítico.
+	sizeWarningScore  = 3.5
+	sizeCriticalScore = 7
+	// O MAD nunca é menor que 5% da mediana, para que planos de tamanho
+	// quase constante não gerem alertas por pequenas variações.
+	sizeMinMADRatio = 0.05
+)
+
+// SizeBaseline é a linha de base do tamanho de um backup: a mediana e o
+// desvio absoluto mediano (MAD) dos backups anteriores, com a faixa fora da
+// qual o tamanho gera alerta.
+type SizeBaseline struct {
+	Samples int
+	Median  float64
+	MAD     float64
+	Lower   float64
+	Upper   float64
+}
+
+// SizeAlert é um backup cujo tamanho fugiu da linha de base do plano.
+type SizeAlert struct {
+	ID             uuid.UUID
+	BackupPlanID   uuid.UUID
+	RestorePointID *uuid.UUID
+	Type           BackupType
+	Kind           SizeAnomalyKind
+	Severity       SizeAlertSeverity
+	SizeBytes      int64
+	BaselineMedian int64
+	BaselineMAD    int64
+	PointCreatedAt time.Time
+	CreatedAt      time.Time
+}
+
+type SizeHistoryEntry struct {
+	RestorePoint RestorePoint
+	// Baseline é nil enquanto não houver backups anteriores suficientes.
+	Baseline *SizeBaseline
+	Alert    *SizeAlert
+}
+
+func NewSizeBaseline(sizes []int64) *SizeBaseline {
+	if len(sizes) < SizeBaselineMinSamples {
+		return nil
+	}
+
+	median := medianOf(sizes)
+	deviations := make([]float64, len(sizes))
+	for i, size := range sizes {
+		deviations[i] = math.Abs(float64(size) - median)
+	}
+	mad := math.Max(medianOfFloats(deviations), median*sizeMinMADRatio)
+
+	band := sizeWarningScore * mad / 0.6745
+	return &SizeBaseline{
+		Samples: len(sizes),
+		Median:  median,
+		MAD:     mad,
+		Lower:   math.Max(median-band, 0),
+		Upper:   median + band,
+	}
+}
+
+// Detect classifica o tamanho em relação à linha de base. Backups vazios são
+// sempre sinalizados; os demais só quando há linha de base.
+func (b *SizeBaseline) Detect(size int64) (SizeAnomalyKind, SizeAlertSeverity, bool) {
+	if size == 0 {
+		if b == nil || b.Median == 0 {
+			return SizeAnomalyZeroBytes, SizeAlertWarning, true
+		}
+		return SizeAnomalyZeroBytes, SizeAlertCritical, true
+	}
+
+	if b == nil || b.MAD == 0 {
+		return "", "", false
+	}
+
+	score := 0.6745 * (float64(size) - b.Median) / b.MAD
+	kind := SizeAnomalyGrowth
+	if score < 0 {
+		kind = SizeAnomalyShrinkage
+	}
+
+	switch score = math.Abs(score); {
+	case score >= sizeCriticalScore:
+		return kind, SizeAlertCritical, true
+	case score >= sizeWarningScore:
+		return kind, SizeAlertWarning, true
+	default:
+		return "", "", false
+	}
+}
+
+// SizeHistory calcula, para cada ponto, a linha de base formada pelos até
+// SizeBaselineWindow backups anteriores do mesmo tipo e o alerta, se houver.
+// Os pontos são devolvidos em ordem de criação.
+func SizeHistory(points []RestorePoint) []SizeHistoryEntry {
+	points = slices.Clone(points)
+	slices.SortStableFunc(points, func(a, b RestorePoint) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	previous := map[BackupType][]int64{}
+	history := make([]SizeHistoryEntry, len(points))
+	for i, point := range points {
+		size := point.SizeBytes.Int64()
+		baseline := NewSizeBaseline(previous[point.Type])
+
+		entry := SizeHistoryEntry{
+			RestorePoint: point,
+			Baseline:     baseline,
+		}
+
+		if kind, severity, ok := baseline.Detect(size); ok {
+			entry.Alert = &SizeAlert{
+				BackupPlanID:   point.BackupPlanID,
+				RestorePointID: &point.ID,
+				Type:           point.Type,
+				Kind:           kind,
+				Severity:       severity,
+				SizeBytes:      size,
+				PointCreatedAt: point.CreatedAt,
+			}
+			if baseline != nil {
+				entry.Alert.BaselineMedian = int64(math.Round(baseline.Median))
+				entry.Alert.BaselineMAD = int64(math.Round(baseline.MAD))
+			}
+		}
+
+		history[i] = entry
+
+		sizes := append(previous[point.Type], size)
+		if len(sizes) > SizeBaselineWindow {
+			sizes = sizes[len(sizes)-SizeBaselineWindow:]
+		}
+		previous[point.Type] = sizes
+	}
+
+	return history
+}
+
+func medianOf(values []int64) float64 {
+	floats := make([]float64, len(values))
+	for i, value := range values {
+		floats[i] = float64(value)
+	}
+	return medianOfFloats(floats)
+}
+
+func medianOfFloats(values []float64) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
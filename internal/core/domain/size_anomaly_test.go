@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewSizeBaseline(t *testing.T) {
+	tests := []struct {
+		name    string
+		sizes   []int64
+		wantNil bool
+		median  float64
+		mad     float64
+	}{
+		{"sem amostras", nil, true, 0, 0},
+		{"menos de cinco amostras", []int64{100, 100, 100, 100}, true, 0, 0},
+		{"cinco amostras", []int64{100, 200, 300, 400, 500}, false, 300, 100},
+		{"quantidade par", []int64{100, 200, 300, 400, 500, 600}, false, 350, 150},
+		{"tamanho constante usa o piso do MAD", []int64{1000, 1000, 1000, 1000, 1000}, false, 1000, 50},
+		{"backups vazios", []int64{0, 0, 0, 0, 0}, false, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseline := NewSizeBaseline(tt.sizes)
+			if tt.wantNil {
+				if baseline != nil {
+					t.Fatalf("NewSizeBaseline = %+v, want nil", baseline)
+				}
+				return
+			}
+
+			if baseline == nil {
+				t.Fatal("NewSizeBaseline = nil")
+			}
+			if baseline.Samples != len(tt.sizes) {
+				t.Errorf("Samples = %d, want %d", baseline.Samples, len(tt.sizes))
+			}
+			if baseline.Median != tt.median {
+				t.Errorf("Median = %v, want %v", baseline.Median, tt.median)
+			}
+			if baseline.MAD != tt.mad {
+				t.Errorf("MAD = %v, want %v", baseline.MAD, tt.mad)
+			}
+
+			band := sizeWarningScore * tt.mad / 0.6745
+			if !closeTo(baseline.Upper, tt.median+band) || !closeTo(baseline.Lower, math.Max(tt.median-band, 0)) {
+				t.Errorf("faixa = [%v, %v], want [%v, %v]", baseline.Lower, baseline.Upper, math.Max(tt.median-band, 0), tt.median+band)
+			}
+		})
+	}
+}
+
+func TestSizeBaselineDetect(t *testing.T) {
+	// Mediana 1000 e MAD 50: o alerta começa em 259,45 bytes de desvio e o
+	// crítico em 518,9.
+	constant := NewSizeBaseline([]int64{1000, 1000, 1000, 1000, 1000})
+	empty := NewSizeBaseline([]int64{0, 0, 0, 0, 0})
+
+	tests := []struct {
+		name     string
+		baseline *SizeBaseline
+		size     int64
+		kind     SizeAnomalyKind
+		severity SizeAlertSeverity
+		ok       bool
+	}{
+		{"vazio sem linha de base", nil, 0, SizeAnomalyZeroBytes, SizeAlertWarning, true},
+		{"vazio com linha de base vazia", empty, 0, SizeAnomalyZeroBytes, SizeAlertWarning, true},
+		{"vazio com linha de base", constant, 0, SizeAnomalyZeroBytes, SizeAlertCritical, true},
+		{"sem linha de base", nil, 5000, "", "", false},
+		{"linha de base sem dispersão", empty, 5000, "", "", false},
+		{"dentro da faixa", constant, 1250, "", "", false},
+		{"crescimento abaixo do alerta", constant, 1259, "", "", false},
+		{"crescimento no alerta", constant, 1260, SizeAnomalyGrowth, SizeAlertWarning, true},
+		{"crescimento abaixo do crítico", constant, 1518, SizeAnomalyGrowth, SizeAlertWarning, true},
+		{"crescimento crítico", constant, 1519, SizeAnomalyGrowth, SizeAlertCritical, true},
+		{"redução no alerta", constant, 740, SizeAnomalyShrinkage, SizeAlertWarning, true},
+		{"redução crítica", constant, 481, SizeAnomalyShrinkage, SizeAlertCritical, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, severity, ok := tt.baseline.Detect(tt.size)
+			if kind != tt.kind || severity != tt.severity || ok != tt.ok {
+				t.Errorf("Detect(%d) = (%q, %q, %v), want (%q, %q, %v)", tt.size, kind, severity, ok, tt.kind, tt.severity, tt.ok)
+			}
+		})
+	}
+}
+
+func TestSizeHistory(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	point := func(day int, backupType BackupType, size int64) RestorePoint {
+		return RestorePoint{
+			ID:           uuid.New(),
+			BackupPlanID: uuid.New(),
+			Type:         backupType,
+			SizeBytes:    big.NewInt(size),
+			CreatedAt:    start.AddDate(0, 0, day),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		points []RestorePoint
+		// samples é a quantidade de amostras da linha de base de cada ponto,
+		// em ordem de criação; zero quando não há linha de base.
+		samples []int
+		// alerts são os alertas esperados por índice, em ordem de criação.
+		alerts map[int]SizeAlertSeverity
+	}{
+		{
+			name: "menos de cinco backups anteriores",
+			points: []RestorePoint{
+				point(0, BackupTypeFull, 1000),
+				point(1, BackupTypeFull, 1000),
+				point(2, BackupTypeFull, 1000),
+				point(3, BackupTypeFull, 1000),
+				point(4, BackupTypeFull, 9000),
+			},
+			samples: []int{0, 0, 0, 0, 0},
+			alerts:  map[int]SizeAlertSeverity{},
+		},
+		{
+			name: "vazio sem linha de base",
+			points: []RestorePoint{
+				point(0, BackupTypeFull, 1000),
+				point(1, BackupTypeIncremental, 0),
+			},
+			samples: []int{0, 0},
+			alerts:  map[int]SizeAlertSeverity{1: SizeAlertWarning},
+		},
+		{
+			name: "tipos separados na linha de base",
+			points: []RestorePoint{
+				point(0, BackupTypeFull, 1000),
+				point(1, BackupTypeIncremental, 10),
+				point(2, BackupTypeFull, 1000),
+				point(3, BackupTypeIncremental, 10),
+				point(4, BackupTypeFull, 1000),
+				point(5, BackupTypeIncremental, 10),
+				point(6, BackupTypeFull, 1000),
+				point(7, BackupTypeIncremental, 10),
+				point(8, BackupTypeFull, 1000),
+				point(9, BackupTypeIncremental, 10),
+				point(10, BackupTypeIncremental, 10),
+				point(11, BackupTypeFull, 1600),
+				point(12, BackupTypeFull, 0),
+			},
+			samples: []int{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 6},
+			alerts:  map[int]SizeAlertSeverity{11: SizeAlertCritical, 12: SizeAlertCritical},
+		},
+		{
+			name: "ordem de criação independe da entrada",
+			points: []RestorePoint{
+				point(5, BackupTypeFull, 1300),
+				point(4, BackupTypeFull, 1000),
+				point(3, BackupTypeFull, 1000),
+				point(2, BackupTypeFull, 1000),
+				point(1, BackupTypeFull, 1000),
+				point(0, BackupTypeFull, 1000),
+			},
+			samples: []int{0, 0, 0, 0, 0, 5},
+			alerts:  map[int]SizeAlertSeverity{5: SizeAlertWarning},
+		},
+		{
+			name: "janela limitada aos últimos backups",
+			points: func() []RestorePoint {
+				var points []RestorePoint
+				for day := range 12 {
+					points = append(points, point(day, BackupTypeFull, 1000))
+				}
+				return points
+			}(),
+			samples: []int{0, 0, 0, 0, 0, 5, 6, 7, 8, 9, 10, 10},
+			alerts:  map[int]SizeAlertSeverity{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := SizeHistory(tt.points)
+			if len(history) != len(tt.points) {
+				t.Fatalf("len(history) = %d, want %d", len(history), len(tt.points))
+			}
+
+			for i, entry := range history {
+				if i > 0 && entry.RestorePoint.CreatedAt.Before(history[i-1].RestorePoint.CreatedAt) {
+					t.Errorf("entrada %d fora da ordem de criação", i)
+				}
+
+				samples := 0
+				if entry.Baseline != nil {
+					samples = entry.Baseline.Samples
+				}
+				if samples != tt.samples[i] {
+					t.Errorf("entrada %d: amostras = %d, want %d", i, samples, tt.samples[i])
+				}
+
+				severity, want := tt.alerts[i]
+				switch {
+				case !want && entry.Alert != nil:
+					t.Errorf("entrada %d: alerta inesperado %+v", i, entry.Alert)
+				case want && entry.Alert == nil:
+					t.Errorf("entrada %d: sem alerta, want %s", i, severity)
+				case want && entry.Alert.Severity != severity:
+					t.Errorf("entrada %d: severidade = %s, want %s", i, entry.Alert.Severity, severity)
+				case want && *entry.Alert.RestorePointID != entry.RestorePoint.ID:
+					t.Errorf("entrada %d: alerta de outro ponto", i)
+				}
+			}
+		})
+	}
+}
+
+func closeTo(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
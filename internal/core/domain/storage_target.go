@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StorageTargetKind string
+
+const (
+	StorageTargetLocal  StorageTargetKind = "local"
+	StorageTargetNASSMB StorageTargetKind = "nas_smb"
+	StorageTargetS3     StorageTargetKind = "s3"
+	StorageTargetTape   StorageTargetKind = "tape"
+)
+
+// StorageTarget é o destino onde os backups dos planos são gravados. Sem
+// CustomerID o destino é compartilhado entre todos os clientes.
+type StorageTarget struct {
+	ID            uuid.UUID
+	CustomerID    *uuid.UUID
+	Name          string
+	Kind          StorageTargetKind
+	Endpoint      string
+	CapacityBytes *big.Int
+	// CredentialsSecretID aponta para as credenciais cifradas do destino.
+	CredentialsSecretID *uuid.UUID
+	// Credentials só existe na entrada; o serviço cifra e descarta o valor
+	// antes de chegar ao repositório.
+	Credentials *StorageTargetCredentials
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// StorageTargetCredentials: para S3, Username é a access key e Password a
+// secret key.
+type StorageTargetCredentials struct {
+	Username string
+	Password string
+}
+
+// StorageTargetPlan é o resumo de um plano vinculado ao destino, com o que é
+// preciso para projetar o uso.
+type StorageTargetPlan struct {
+	BackupPlanID      uuid.UUID
+	Name              string
+	BackupSizeBytes   *big.Int
+	RunsPerWeek       int
+	RetentionPolicyID *uuid.UUID
+}
+
+type StorageTargetPlanUsage struct {
+	BackupPlanID    uuid.UUID
+	Name            string
+	BackupSizeBytes *big.Int
+	RetainedCopies  int
+	ProjectedBytes  *big.Int
+}
+
+type StorageTargetUsage struct {
+	StorageTargetID uuid.UUID
+	CapacityBytes   *big.Int
+	ProjectedBytes  *big.Int
+	AvailableBytes  *big.Int
+	UsagePercent    float64
+	Plans           []StorageTargetPlanUsage
+}
+
+func (t *StorageTarget) Validate() error {
+	switch t.Kind {
+	case StorageTargetLocal, StorageTargetNASSMB, StorageTargetS3, StorageTargetTape:
+	default:
+		return ErrInvalidStorageTarget
+	}
+
+	if strings.TrimSpace(t.Endpoint) == "" || t.CapacityBytes == nil || t.CapacityBytes.Sign() <= 0 {
+		return ErrInvalidStorageTarget
+	}
+
+	return nil
+}
+
+// AvailableTo indica se planos do cliente podem usar o destino.
+func (t *StorageTarget) AvailableTo(customerID uuid.UUID) bool {
+	return t.CustomerID == nil || *t.CustomerID == customerID
+}
+
+// EstimatedCopies estima quantas cópias de um plano ficam guardadas pela
+// política. As regras de contagem são somadas sem descontar sobreposições e
+// MaxAgeDays limita o total às execuções que cabem no período, então a
+// estimativa erra para cima. Sem política, só a última cópia é considerada.
+func (p *RetentionPolicy) EstimatedCopies(runsPerWeek int) int {
+	if p == nil {
+		return 1
+	}
+
+	copies := p.KeepLast + p.KeepDaily + p.KeepWeekly + p.KeepMonthly + p.KeepYearly
+
+	if p.MaxAgeDays > 0 {
+		runs := (p.MaxAgeDays*runsPerWeek + 6) / 7
+		if copies == 0 || runs < copies {
+			copies = runs
+		}
+	}
+
+	return max(copies, 1)
+}
+
+// ProjectUsage soma o tamanho de cada plano multiplicado pelas cópias que a
+// política de retenção dele mantém.
+func (t *StorageTarget) ProjectUsage(plans []StorageTargetPlan, policies map[uuid.UUID]*RetentionPolicy) *StorageTargetUsage {
+	usage := &StorageTargetUsage{
+		StorageTargetID: t.ID,
+		CapacityBytes:   t.CapacityBytes,
+		ProjectedBytes:  new(big.Int),
+		Plans:           make([]StorageTargetPlanUsage, 0, len(plans)),
+	}
+
+	for _, plan := range plans {
+		var policy *RetentionPolicy
+		if plan.RetentionPolicyID != nil {
+			policy = policies[*plan.RetentionPolicyID]
+		}
+
+		copies := policy.EstimatedCopies(plan.RunsPerWeek)
+		projected := new(big.Int).Mul(plan.BackupSizeBytes, big.NewInt(int64(copies)))
+		usage.ProjectedBytes.Add(usage.ProjectedBytes, projected)
+
+		usage.Plans = append(usage.Plans, StorageTargetPlanUsage{
+			BackupPlanID:    plan.BackupPlanID,
+			Name:            plan.Name,
+			BackupSizeBytes: plan.BackupSizeBytes,
+			RetainedCopies:  copies,
+			ProjectedBytes:  projected,
+		})
+	}
+
+	usage.AvailableBytes = new(big.Int).Sub(t.CapacityBytes, usage.ProjectedBytes)
+	if usage.AvailableBytes.Sign() < 0 {
+		usage.AvailableBytes.SetInt64(0)
+	}
+
+	percent, _ := new(big.Float).Quo(
+		new(big.Float).SetInt(usage.ProjectedBytes),
+		new(big.Float).SetInt(t.CapacityBytes),
+	).Float64()
+	usage.UsagePercent = percent * 100
+
+	return usage
+}
+
+// Exceeds indica se a projeção passa da capacidade do destino.
+func (u *StorageTargetUsage) Exceeds() bool {
+	return u.ProjectedBytes.Cmp(u.CapacityBytes) > 0
+}
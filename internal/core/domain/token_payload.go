@@ -1,9 +1,29 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
 
 type TokenPayload struct {
 	ID     uuid.UUID
 	UserID uuid.UUID
 	Role   UserRole
+	// MFA indica que o token foi emitido após a validação do segundo fator.
+	MFA bool
+	// PasswordChangeRequired restringe o token à troca de senha.
+	PasswordChangeRequired bool
+	// APIKeyID e Scopes só são preenchidos quando a requisição usa uma chave
+	// de API; tokens de login não têm restrição de escopo.
+	APIKeyID uuid.UUID
+	Scopes   []string
+}
+
+func (tp *TokenPayload) IsAPIKey() bool {
+	return tp.APIKeyID != uuid.Nil
+}
+
+func (tp *TokenPayload) HasScope(scope string) bool {
+	return !tp.IsAPIKey() || slices.Contains(tp.Scopes, scope)
 }
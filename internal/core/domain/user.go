@@ -14,12 +14,23 @@ const (
 )
 
 type User struct {
-	ID        uuid.UUID
-	Fullname  string
-	Email     string
-	Username  string
-	Password  string
-	Role      UserRole
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID                  uuid.UUID
+	Fullname            string
+	Email               string
+	Username            string
+	Password            string
+	Role                UserRole
+	FailedLoginAttempts int
+	LockedUntil         *time.Time
+	MFAEnabled          bool
+	TOTPSecret          string
+	TOTPLastStep        int64
+	EmailVerifiedAt     *time.Time
+	MustChangePassword  bool
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+func (u *User) IsLocked(now time.Time) bool {
+	return u.LockedUntil != nil && now.Before(*u.LockedUntil)
 }
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserTokenPurpose string
+
+const (
+	PasswordReset     UserTokenPurpose = "password_reset"
+	EmailVerification UserTokenPurpose = "email_verification"
+)
+
+// UserToken é um token de uso único enviado por e-mail. Apenas o hash é
+// persistido; o valor original só existe na mensagem enviada ao usuário.
+type UserToken struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Purpose   UserTokenPurpose
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type AccountPolicy struct {
+	// BaseURL é a URL do frontend que recebe os links enviados por e-mail.
+	BaseURL                  string
+	PasswordResetTTL         time.Duration
+	EmailVerificationTTL     time.Duration
+	RequireEmailVerification bool
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type VerificationKind string
+
+const (
+	VerificationVerify      VerificationKind = "verify"
+	VerificationRestoreTest VerificationKind = "restore_test"
+)
+
+type VerificationJobStatus string
+
+const (
+	VerificationPending   VerificationJobStatus = "pending"
+	VerificationSucceeded VerificationJobStatus = "succeeded"
+	VerificationFailed    VerificationJobStatus = "failed"
+)
+
+// VerificationSchedule define de quanto em quanto tempo os backups do plano
+// precisam ser verificados. A verificação fica atrasada quando passa
+// GraceDays do vencimento sem uma verificação bem-sucedida.
+type VerificationSchedule struct {
+	BackupPlanID uuid.UUID
+	Kind         VerificationKind
+	IntervalDays int
+	GraceDays    int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// VerificationStatus resume a situação de verificação de um plano.
+type VerificationStatus struct {
+	BackupPlanID   uuid.UUID
+	BackupPlanName string
+	Schedule       VerificationSchedule
+	LastVerifiedAt *time.Time
+	DueAt          time.Time
+	Overdue        bool
+}
+
+// VerificationJob é uma verificação gerada para o agente, executada após o
+// backup do horário ScheduledAt. O resultado fica associado ao ponto de
+// restauração verificado.
+type VerificationJob struct {
+	ID             uuid.UUID
+	BackupPlanID   uuid.UUID
+	Kind           VerificationKind
+	Status         VerificationJobStatus
+	ScheduledAt    time.Time
+	RestorePointID *uuid.UUID
+	Result         string
+	CompletedAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (s *VerificationSchedule) Validate() error {
+	switch s.Kind {
+	case VerificationVerify, VerificationRestoreTest:
+	default:
+		return ErrInvalidVerificationSchedule.WithDetails(map[string]string{"kind": "verification.invalid_kind"})
+	}
+
+	if s.IntervalDays < 1 {
+		return ErrInvalidVerificationSchedule.WithDetails(map[string]string{"interval_days": "verification.invalid_interval"})
+	}
+
+	if s.GraceDays < 0 {
+		return ErrInvalidVerificationSchedule.WithDetails(map[string]string{"grace_days": "verification.invalid_grace"})
+	}
+
+	return nil
+}
+
+// Status calcula o vencimento a partir da última verificação bem-sucedida;
+// sem nenhuma, o plano vence um intervalo depois de a agenda ser criada.
+func (s *VerificationSchedule) Status(lastVerifiedAt *time.Time, now time.Time) VerificationStatus {
+	from := s.CreatedAt
+	if lastVerifiedAt != nil {
+		from = *lastVerifiedAt
+	}
+	dueAt := from.AddDate(0, 0, s.IntervalDays)
+
+	return VerificationStatus{
+		BackupPlanID:   s.BackupPlanID,
+		Schedule:       *s,
+		LastVerifiedAt: lastVerifiedAt,
+		DueAt:          dueAt,
+		Overdue:        now.After(dueAt.AddDate(0, 0, s.GraceDays)),
+	}
+}
+
+func (j *VerificationJob) Complete(restorePointID uuid.UUID, success bool, result string, now time.Time) error {
+	if j.Status != VerificationPending {
+		return ErrVerificationJobState
+	}
+
+	j.Status = VerificationFailed
+	if success {
+		j.Status = VerificationSucceeded
+	}
+	j.RestorePointID = &restorePointID
+	j.Result = result
+	j.CompletedAt = &now
+	return nil
+}
@@ -0,0 +1,223 @@
+package i18n
+
+var en = map[string]string{
+	"ERR_BAD_REQUEST":              "Invalid request",
+	"ERR_INVALID_JSON":             "Invalid JSON",
+	"ERR_INVALID_UUID":             "Invalid UUID",
+	"ERR_INVALID_PAGINATION":       "Page and limit are required and must be valid numbers",
+	"ERR_VALIDATION":               "Invalid input data",
+	"ERR_SERVICE_UNAVAILABLE":      "Service unavailable",
+	"ERR_FORBIDDEN":                "Access denied",
+	"ERR_INTERNAL_ERROR":           "Internal server error",
+	"ERR_DATA_NOT_FOUND":           "Resource not found",
+	"ERR_CONFLICTING_DATA":         "Conflicting data",
+	"ERR_INVALID_CREDENTIALS":      "Invalid username or password",
+	"ERR_UNAUTHORIZED":             "Authentication failed",
+	"ERR_TOKEN_REQUIRED":           "Token signing key is not configured",
+	"ERR_TOKEN_CREATION_ERROR":     "Failed to create token",
+	"ERR_TOKEN_DURATION_ERROR":     "Invalid token duration",
+	"ERR_EXPIRED_TOKEN":            "Token expired",
+	"ERR_INVALID_TOKEN":            "Invalid token",
+	"ERR_EMPTY_AUTH_HEADER":        "Missing authorization header",
+	"ERR_INVALID_AUTH_HEADER":      "Invalid authorization header",
+	"ERR_INVALID_AUTH_TYPE":        "Unsupported authorization type",
+	"ERR_INVALID_AUTH_PAYLOAD":     "Invalid authentication data",
+	"ERR_TOO_MANY_REQUESTS":        "Too many attempts, try again later",
+	"ERR_MFA_REQUIRED":             "Two-factor authentication is required for this role",
+	"ERR_INVALID_MFA_CODE":         "Invalid verification code",
+	"ERR_MFA_NOT_ENROLLED":         "Two-factor authentication is not enrolled",
+	"ERR_INVALID_USER_TOKEN":       "Invalid or expired link",
+	"ERR_EMAIL_NOT_VERIFIED":       "Email address not verified yet",
+	"ERR_WEAK_PASSWORD":            "The password does not meet the password policy",
+	"ERR_PASSWORD_BREACHED":        "The password appears in known breaches, choose another one",
+	"ERR_PASSWORD_REUSED":          "The password was used recently, choose another one",
+	"ERR_INVALID_CURRENT_PASSWORD": "Current password is incorrect",
+	"ERR_PASSWORD_CHANGE_REQUIRED": "You must change your password before continuing",
+	"ERR_MFA_ALREADY_ENABLED":      "Two-factor authentication is already enabled",
+	"ERR_INVALID_API_KEY":          "Invalid, expired or revoked API key",
+	"ERR_INSUFFICIENT_SCOPE":       "The API key does not have the required scope",
+	"ERR_INVALID_OIDC_STATE":       "Invalid or expired single sign-on attempt, try again",
+	"ERR_OIDC_PROVIDER":            "Failed to communicate with the identity provider",
+	"ERR_SSO_NOT_PROVISIONED":      "Identity provider user is not registered",
+	"ERR_SSO_LINK_REQUIRED":        "Administrative account: sign in with your password and link the identity provider before using single sign-on",
+	"ERR_SESSION_REVOKED":          "Session ended, please log in again",
+	"ERR_INVALID_RETENTION_POLICY": "The retention policy needs at least one rule and does not accept negative values",
+	"ERR_RETENTION_CUSTOMER":       "The retention policy belongs to another customer",
+	"ERR_NO_RETENTION_POLICY":      "The backup plan has no retention policy",
+	"ERR_INVALID_STORAGE_TARGET":   "Invalid storage target: provide kind, endpoint and a capacity greater than zero",
+	"ERR_STORAGE_TARGET_CUSTOMER":  "The storage target belongs to another customer",
+	"ERR_CAPACITY_EXCEEDED":        "The plan exceeds the projected capacity of the storage target",
+	"ERR_SECRETS_NOT_CONFIGURED":   "Secrets master key is not configured",
+	"ERR_NO_FULL_BACKUP":           "The plan needs at least one full backup",
+	"ERR_INVALID_BACKUP_TYPE":      "Invalid backup type: use full, incremental or differential",
+	"ERR_INVALID_BACKUP_SOURCE":    "Invalid backup source: provide kind, location and valid include and exclude patterns",
+	"ERR_INVALID_AGENT_TOKEN":      "Invalid or revoked agent token",
+	"ERR_INVALID_AGENT_COMMAND":    "Invalid command",
+	"ERR_AGENT_COMMAND_STATE":      "The command has already finished or expired",
+	"ERR_RESTORE_REQUEST_STATE":    "The restore request does not allow this operation in its current state",
+	"ERR_SELF_APPROVAL":            "The requester cannot approve or reject their own restore request",
+	"ERR_RESTORE_TARGET_DEVICE":    "The target device must belong to the same customer as the plan",
+	"ERR_INVALID_RESTORE_POINT":    "Invalid restore point",
+	"ERR_INVALID_VERIFICATION":     "Invalid verification schedule",
+	"ERR_VERIFICATION_JOB_STATE":   "The verification has already finished",
+	"ERR_ACCOUNT_LOCKED":           "Account temporarily locked due to too many failed attempts",
+
+	"health.ok":        "api is healthy",
+	"health.not_ready": "API is not ready",
+
+	"auth.login":        "Successfully authenticated",
+	"auth.mfa_required": "Enter the verification code to complete the login",
+	"auth.oidc_link":    "Continue at the identity provider to link your account",
+
+	"account.forgot_password":   "If the email is registered, you will receive instructions to reset your password",
+	"account.password_reset":    "Password reset successfully",
+	"account.email_verified":    "Email verified successfully",
+	"account.verification_sent": "If the email is registered and pending verification, a new link has been sent",
+
+	"mail.password_reset.subject":     "Password reset",
+	"mail.password_reset.body":        "Hello, %s.\n\nWe received a request to reset your password. To choose a new password, open the link below:\n\n%s\n\nThe link can be used only once and expires in %s. If you did not make this request, ignore this email.",
+	"mail.email_verification.subject": "Verify your email",
+	"mail.email_verification.body":    "Hello, %s.\n\nTo verify your email address, open the link below:\n\n%s\n\nThe link expires in %s.",
+
+	"password.changed":       "Password changed successfully, please log in again",
+	"password.change_forced": "The user will have to change the password on next login",
+	"password.min_length":    "must be at least %d characters long",
+	"password.max_length":    "must be at most %d characters long",
+	"password.upper":         "must contain at least one uppercase letter",
+	"password.lower":         "must contain at least one lowercase letter",
+	"password.digit":         "must contain at least one digit",
+	"password.symbol":        "must contain at least one symbol",
+
+	"duration.hours":   "%d hour(s)",
+	"duration.minutes": "%d minute(s)",
+
+	"mfa.enrolled": "Two-factor authentication enrolled, confirm it with a code from your app",
+	"mfa.enabled":  "Two-factor authentication enabled",
+	"mfa.disabled": "Two-factor authentication disabled",
+
+	"api_key.created":         "API key created, store it now because it will not be shown again",
+	"api_key.list":            "API key list",
+	"api_key.revoked":         "API key revoked",
+	"api_key.expires_in_past": "must be a future date",
+
+	"session.list":        "Active sessions",
+	"session.revoked":     "Session ended",
+	"session.revoked_all": "All sessions of the user were ended",
+
+	"user.created":  "User registered successfully",
+	"user.found":    "User found",
+	"user.list":     "User list",
+	"user.updated":  "User updated",
+	"user.deleted":  "User deleted successfully",
+	"user.unlocked": "User unlocked",
+
+	"customer.created": "Customer registered successfully",
+	"customer.found":   "Customer found",
+	"customer.list":    "Customer list",
+	"customer.updated": "Customer updated successfully",
+	"customer.deleted": "Customer deleted successfully",
+
+	"device.created": "Device linked successfully",
+	"device.found":   "Device found",
+	"device.list":    "Device list",
+	"device.updated": "Device updated",
+	"device.deleted": "Device deleted successfully",
+
+	"backup_plan.created":             "Backup plan created successfully",
+	"backup_plan.found":               "Backup plan found",
+	"backup_plan.list":                "Backup plan list",
+	"backup_plan.updated":             "Backup plan updated",
+	"backup_plan.deleted":             "Backup plan deleted successfully",
+	"backup_plan.no_full_backup":      "must include at least one full backup",
+	"backup_plan.invalid_backup_type": "backup_type must be full, incremental or differential",
+	"backup_plan.invalid_day":         "day must be Sunday, Monday, Tuesday, Wednesday, Thursday, Friday or Saturday",
+	"backup_plan.has_restore_points":  "the plan still has valid restore points",
+
+	"retention_policy.created":   "Retention policy created successfully",
+	"retention_policy.found":     "Retention policy found",
+	"retention_policy.list":      "Retention policy list",
+	"retention_policy.updated":   "Retention policy updated",
+	"retention_policy.deleted":   "Retention policy deleted successfully",
+	"retention_policy.evaluated": "Retention evaluated",
+
+	"storage_target.created": "Storage target created successfully",
+	"storage_target.found":   "Storage target found",
+	"storage_target.list":    "Storage target list",
+	"storage_target.updated": "Storage target updated",
+	"storage_target.deleted": "Storage target deleted successfully",
+	"storage_target.usage":   "Projected storage target usage",
+
+	"secret.rotated": "Secrets re-encrypted with the current master key",
+
+	"agent.token_issued": "Agent token issued; store it now, it will not be shown again",
+	"agent.config":       "Agent configuration",
+
+	"agent_command.created":             "Command queued",
+	"agent_command.found":               "Command found",
+	"agent_command.list":                "Command list",
+	"agent_command.acknowledged":        "Command acknowledged",
+	"agent_command.completed":           "Command result recorded",
+	"agent_command.invalid_type":        "must be run_backup, verify, restore_test or update_agent",
+	"agent_command.plan_required":       "is required for plan commands",
+	"agent_command.plan_not_allowed":    "is not accepted by update_agent",
+	"agent_command.plan_device":         "must be a plan of the device",
+	"agent_command.version_required":    "is required by update_agent",
+	"agent_command.version_not_allowed": "is only accepted by update_agent",
+	"agent_command.ttl_exceeded":        "cannot be negative or exceed the configured maximum",
+
+	"restore_request.created":   "Restore requested",
+	"restore_request.found":     "Restore request found",
+	"restore_request.list":      "Restore request list",
+	"restore_request.approved":  "Restore request approved",
+	"restore_request.rejected":  "Restore request rejected",
+	"restore_request.started":   "Restore started",
+	"restore_request.completed": "Restore result recorded",
+
+	"restore_point.reported":            "Restore points recorded",
+	"restore_point.list":                "Restore point list",
+	"restore_point.deleted":             "Restore point deleted",
+	"restore_point.size_history":        "Backup size history",
+	"restore_point.size_alerts":         "Backup size alert list",
+	"restore_point.invalid_type":        "must be full, incremental or differential",
+	"restore_point.invalid_size":        "must be a non-negative integer of at most 9223372036854775807 bytes",
+	"restore_point.location_required":   "is required",
+	"restore_point.created_at_required": "is required",
+	"restore_point.not_found":           "does not exist",
+	"restore_point.other_plan":          "belongs to another plan",
+	"restore_point.expired":             "has expired",
+
+	"verification.schedule_saved":        "Verification schedule saved",
+	"verification.schedule_found":        "Verification schedule found",
+	"verification.schedule_deleted":      "Verification schedule deleted",
+	"verification.job_list":              "Verification job list",
+	"verification.completed":             "Verification result recorded",
+	"verification.report":                "Verification report",
+	"verification.invalid_kind":          "must be verify or restore_test",
+	"verification.invalid_interval":      "must be at least one day",
+	"verification.invalid_grace":         "cannot be negative",
+	"verification.point_before_schedule": "must be created at or after the scheduled time of the verification",
+
+	"validation.required":   "The field '%s' is required",
+	"validation.email":      "The field '%s' must be a valid email",
+	"validation.oneof":      "The field '%s' must be one of: %s",
+	"validation.uuid":       "The field '%s' must contain a valid UUID",
+	"validation.uuid4":      "The field '%s' must contain a valid UUID v4",
+	"validation.min":        "The field '%s' must have at least '%s' characters",
+	"validation.max":        "The field '%s' must have at most '%s' characters",
+	"validation.len":        "The field '%s' must have exactly '%s' characters",
+	"validation.min_items":  "The field '%s' must have at least '%s' items",
+	"validation.max_items":  "The field '%s' must have at most '%s' items",
+	"validation.len_items":  "The field '%s' must have exactly '%s' items",
+	"validation.min_number": "The field '%s' must be at least '%s'",
+	"validation.max_number": "The field '%s' must be at most '%s'",
+	"validation.len_number": "The field '%s' must be equal to '%s'",
+	"validation.gte":        "The field '%s' must be greater than or equal to '%s'",
+	"validation.lte":        "The field '%s' must be less than or equal to '%s'",
+	"validation.gt":         "The field '%s' must be greater than '%s'",
+	"validation.lt":         "The field '%s' must be less than '%s'",
+	"validation.alphanum":   "The field '%s' must contain only alphanumeric characters",
+	"validation.numeric":    "The field '%s' must be numeric",
+	"validation.url":        "The field '%s' must be a valid URL",
+	"validation.datetime":   "The field '%s' must match the date/time layout '%s'",
+	"validation.default":    "The field '%s' is invalid (%s)",
+}
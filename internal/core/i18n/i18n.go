@@ -0,0 +1,109 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Lang string
+
+const (
+	PtBR Lang = "pt-BR"
+	En   Lang = "en"
+
+	Default = PtBR
+)
+
+var catalogue = map[Lang]map[string]string{
+	PtBR: ptBR,
+	En:   en,
+}
+
+type langKey struct{}
+
+func WithLang(ctx context.Context, lang Lang) context.Context {
+	return context.WithValue(ctx, langKey{}, lang)
+}
+
+func FromContext(ctx context.Context) Lang {
+	if lang, ok := ctx.Value(langKey{}).(Lang); ok {
+		return lang
+	}
+	return Default
+}
+
+// T traduz a chave para o idioma informado. Chaves ausentes caem para o
+// idioma padrão e, em último caso, a própria chave é devolvida.
+func T(lang Lang, key string, args ...any) string {
+	msg, ok := catalogue[lang][key]
+	if !ok {
+		msg, ok = catalogue[Default][key]
+	}
+	if !ok {
+		return key
+	}
+
+	if len(args) > 0 {
+		return fmt.Sprintf(msg, args...)
+	}
+	return msg
+}
+
+// Has informa se a chave existe no catálogo do idioma padrão.
+func Has(key string) bool {
+	_, ok := catalogue[Default][key]
+	return ok
+}
+
+// Parse escolhe o idioma suportado de maior peso em um cabeçalho
+// Accept-Language, por exemplo "en-US,en;q=0.9,pt;q=0.8".
+func Parse(acceptLanguage string) Lang {
+	type candidate struct {
+		lang Lang
+		q    float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+
+		if lang, ok := match(tag); ok && q > 0 {
+			candidates = append(candidates, candidate{lang, q})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return Default
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+	return candidates[0].lang
+}
+
+func match(tag string) (Lang, bool) {
+	primary, _, _ := strings.Cut(strings.ToLower(tag), "-")
+	switch primary {
+	case "pt":
+		return PtBR, true
+	case "en":
+		return En, true
+	}
+	return "", false
+}
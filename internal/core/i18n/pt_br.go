@@ -0,0 +1,223 @@
+package i18n
+
+var ptBR = map[string]string{
+	"ERR_BAD_REQUEST":              "Requisição inválida",
+	"ERR_INVALID_JSON":             "JSON inválido",
+	"ERR_INVALID_UUID":             "UUID inválido",
+	"ERR_INVALID_PAGINATION":       "Page e limit são obrigatórios e devem ser números válidos",
+	"ERR_VALIDATION":               "Dados de entrada inválidos",
+	"ERR_SERVICE_UNAVAILABLE":      "Serviço indisponível",
+	"ERR_FORBIDDEN":                "Acesso negado",
+	"ERR_INTERNAL_ERROR":           "Erro interno do servidor",
+	"ERR_DATA_NOT_FOUND":           "Recurso não encontrado",
+	"ERR_CONFLICTING_DATA":         "Conflito de dados",
+	"ERR_INVALID_CREDENTIALS":      "Usuário ou senha inválidos",
+	"ERR_UNAUTHORIZED":             "Falha na autenticação",
+	"ERR_TOKEN_REQUIRED":           "Chave de assinatura do token não configurada",
+	"ERR_TOKEN_CREATION_ERROR":     "Falha ao gerar o token",
+	"ERR_TOKEN_DURATION_ERROR":     "Duração do token inválida",
+	"ERR_EXPIRED_TOKEN":            "Token expirado",
+	"ERR_INVALID_TOKEN":            "Token inválido",
+	"ERR_EMPTY_AUTH_HEADER":        "Cabeçalho de autorização ausente",
+	"ERR_INVALID_AUTH_HEADER":      "Cabeçalho de autorização inválido",
+	"ERR_INVALID_AUTH_TYPE":        "Tipo de autorização não suportado",
+	"ERR_INVALID_AUTH_PAYLOAD":     "Dados de autenticação inválidos",
+	"ERR_TOO_MANY_REQUESTS":        "Muitas tentativas, tente novamente mais tarde",
+	"ERR_MFA_REQUIRED":             "Autenticação em dois fatores obrigatória para este perfil",
+	"ERR_INVALID_MFA_CODE":         "Código de verificação inválido",
+	"ERR_MFA_NOT_ENROLLED":         "Autenticação em dois fatores não cadastrada",
+	"ERR_INVALID_USER_TOKEN":       "Link inválido ou expirado",
+	"ERR_EMAIL_NOT_VERIFIED":       "E-mail ainda não confirmado",
+	"ERR_WEAK_PASSWORD":            "A senha não atende à política de senhas",
+	"ERR_PASSWORD_BREACHED":        "A senha aparece em vazamentos conhecidos, escolha outra",
+	"ERR_PASSWORD_REUSED":          "A senha já foi usada recentemente, escolha outra",
+	"ERR_INVALID_CURRENT_PASSWORD": "Senha atual incorreta",
+	"ERR_PASSWORD_CHANGE_REQUIRED": "É necessário trocar a senha antes de continuar",
+	"ERR_MFA_ALREADY_ENABLED":      "Autenticação em dois fatores já está ativa",
+	"ERR_INVALID_API_KEY":          "Chave de API inválida, expirada ou revogada",
+	"ERR_INSUFFICIENT_SCOPE":       "A chave de API não tem o escopo necessário",
+	"ERR_INVALID_OIDC_STATE":       "Login único inválido ou expirado, tente novamente",
+	"ERR_OIDC_PROVIDER":            "Falha ao comunicar com o provedor de identidade",
+	"ERR_SSO_NOT_PROVISIONED":      "Usuário do provedor de identidade não cadastrado",
+	"ERR_SSO_LINK_REQUIRED":        "Conta administrativa: entre com a senha e vincule o provedor de identidade antes de usar o login único",
+	"ERR_SESSION_REVOKED":          "Sessão encerrada, faça login novamente",
+	"ERR_INVALID_RETENTION_POLICY": "A política de retenção precisa de ao menos uma regra e não aceita valores negativos",
+	"ERR_RETENTION_CUSTOMER":       "A política de retenção pertence a outro cliente",
+	"ERR_NO_RETENTION_POLICY":      "O plano de backup não tem política de retenção",
+	"ERR_INVALID_STORAGE_TARGET":   "Destino de armazenamento inválido: informe tipo, endereço e capacidade maior que zero",
+	"ERR_STORAGE_TARGET_CUSTOMER":  "O destino de armazenamento pertence a outro cliente",
+	"ERR_CAPACITY_EXCEEDED":        "O plano ultrapassa a capacidade projetada do destino de armazenamento",
+	"ERR_SECRETS_NOT_CONFIGURED":   "Chave mestra de segredos não configurada",
+	"ERR_NO_FULL_BACKUP":           "O plano precisa de ao menos um backup completo",
+	"ERR_INVALID_BACKUP_TYPE":      "Tipo de backup inválido: use full, incremental ou differential",
+	"ERR_INVALID_BACKUP_SOURCE":    "Origem de backup inválida: informe tipo, local e padrões de inclusão e exclusão válidos",
+	"ERR_INVALID_AGENT_TOKEN":      "Token do agente inválido ou revogado",
+	"ERR_INVALID_AGENT_COMMAND":    "Comando inválido",
+	"ERR_AGENT_COMMAND_STATE":      "O comando já foi concluído ou expirou",
+	"ERR_RESTORE_REQUEST_STATE":    "O pedido de restauração não permite essa operação no estado atual",
+	"ERR_SELF_APPROVAL":            "Quem solicitou a restauração não pode aprová-la ou rejeitá-la",
+	"ERR_RESTORE_TARGET_DEVICE":    "O dispositivo de destino precisa ser do mesmo cliente do plano",
+	"ERR_INVALID_RESTORE_POINT":    "Ponto de restauração inválido",
+	"ERR_INVALID_VERIFICATION":     "Agenda de verificação inválida",
+	"ERR_VERIFICATION_JOB_STATE":   "A verificação já foi concluída",
+	"ERR_ACCOUNT_LOCKED":           "Conta bloqueada temporariamente por excesso de tentativas",
+
+	"health.ok":        "api está saudável",
+	"health.not_ready": "API não está pronta",
+
+	"auth.login":        "Autenticado com sucesso",
+	"auth.mfa_required": "Informe o código de verificação para concluir o login",
+	"auth.oidc_link":    "Continue no provedor de identidade para vincular sua conta",
+
+	"account.forgot_password":   "Se o e-mail estiver cadastrado, você receberá as instruções para redefinir a senha",
+	"account.password_reset":    "Senha redefinida com sucesso",
+	"account.email_verified":    "E-mail confirmado com sucesso",
+	"account.verification_sent": "Se o e-mail estiver cadastrado e pendente de confirmação, um novo link foi enviado",
+
+	"mail.password_reset.subject":     "Redefinição de senha",
+	"mail.password_reset.body":        "Olá, %s.\n\nRecebemos um pedido para redefinir a sua senha. Para escolher uma nova senha, acesse o link abaixo:\n\n%s\n\nO link pode ser usado uma única vez e expira em %s. Se você não fez este pedido, ignore este e-mail.",
+	"mail.email_verification.subject": "Confirme o seu e-mail",
+	"mail.email_verification.body":    "Olá, %s.\n\nPara confirmar o seu e-mail, acesse o link abaixo:\n\n%s\n\nO link expira em %s.",
+
+	"password.changed":       "Senha alterada com sucesso, faça login novamente",
+	"password.change_forced": "O usuário deverá trocar a senha no próximo acesso",
+	"password.min_length":    "deve ter no mínimo %d caracteres",
+	"password.max_length":    "deve ter no máximo %d caracteres",
+	"password.upper":         "deve conter ao menos uma letra maiúscula",
+	"password.lower":         "deve conter ao menos uma letra minúscula",
+	"password.digit":         "deve conter ao menos um número",
+	"password.symbol":        "deve conter ao menos um símbolo",
+
+	"duration.hours":   "%d hora(s)",
+	"duration.minutes": "%d minuto(s)",
+
+	"mfa.enrolled": "Autenticação em dois fatores cadastrada, confirme com um código do aplicativo",
+	"mfa.enabled":  "Autenticação em dois fatores ativada",
+	"mfa.disabled": "Autenticação em dois fatores desativada",
+
+	"api_key.created":         "Chave de API criada, guarde-a agora pois ela não será exibida novamente",
+	"api_key.list":            "Lista de chaves de API",
+	"api_key.revoked":         "Chave de API revogada",
+	"api_key.expires_in_past": "deve ser uma data futura",
+
+	"session.list":        "Sessões ativas",
+	"session.revoked":     "Sessão encerrada",
+	"session.revoked_all": "Todas as sessões do usuário foram encerradas",
+
+	"user.created":  "Usuário cadastrado com sucesso",
+	"user.found":    "Usuário encontrado",
+	"user.list":     "Lista de usuários",
+	"user.updated":  "Usuário atualizado",
+	"user.deleted":  "Usuário deletado com sucesso",
+	"user.unlocked": "Usuário desbloqueado",
+
+	"customer.created": "Cliente cadastrado com sucesso",
+	"customer.found":   "Cliente encontrado",
+	"customer.list":    "Lista de clientes",
+	"customer.updated": "Cliente alterado com sucesso",
+	"customer.deleted": "Cliente deletado com sucesso",
+
+	"device.created": "Dispositivo vinculado com sucesso",
+	"device.found":   "Dispositivo encontrado",
+	"device.list":    "Lista de dispositivos",
+	"device.updated": "Dispositivo atualizado",
+	"device.deleted": "Dispositivo deletado com sucesso",
+
+	"backup_plan.created":             "Plano de backup criado com sucesso",
+	"backup_plan.found":               "Plano de backup encontrado",
+	"backup_plan.list":                "Lista de planos de backup",
+	"backup_plan.updated":             "Plano de backup atualizado",
+	"backup_plan.deleted":             "Plano de backup deletado com sucesso",
+	"backup_plan.no_full_backup":      "precisa incluir ao menos um backup completo",
+	"backup_plan.invalid_backup_type": "backup_type deve ser full, incremental ou differential",
+	"backup_plan.invalid_day":         "day deve ser Sunday, Monday, Tuesday, Wednesday, Thursday, Friday ou Saturday",
+	"backup_plan.has_restore_points":  "o plano ainda tem pontos de restauração válidos",
+
+	"retention_policy.created":   "Política de retenção criada com sucesso",
+	"retention_policy.found":     "Política de retenção encontrada",
+	"retention_policy.list":      "Lista de políticas de retenção",
+	"retention_policy.updated":   "Política de retenção atualizada",
+	"retention_policy.deleted":   "Política de retenção excluída com sucesso",
+	"retention_policy.evaluated": "Retenção avaliada",
+
+	"storage_target.created": "Destino de armazenamento cadastrado com sucesso",
+	"storage_target.found":   "Destino de armazenamento encontrado",
+	"storage_target.list":    "Lista de destinos de armazenamento",
+	"storage_target.updated": "Destino de armazenamento atualizado",
+	"storage_target.deleted": "Destino de armazenamento excluído com sucesso",
+	"storage_target.usage":   "Uso projetado do destino de armazenamento",
+
+	"secret.rotated": "Segredos cifrados novamente com a chave mestra atual",
+
+	"agent.token_issued": "Token do agente emitido; guarde-o agora, ele não será exibido novamente",
+	"agent.config":       "Configuração do agente",
+
+	"agent_command.created":             "Comando enfileirado",
+	"agent_command.found":               "Comando encontrado",
+	"agent_command.list":                "Lista de comandos",
+	"agent_command.acknowledged":        "Recebimento do comando confirmado",
+	"agent_command.completed":           "Resultado do comando registrado",
+	"agent_command.invalid_type":        "deve ser run_backup, verify, restore_test ou update_agent",
+	"agent_command.plan_required":       "é obrigatório em comandos de plano",
+	"agent_command.plan_not_allowed":    "não é aceito por update_agent",
+	"agent_command.plan_device":         "deve ser um plano do dispositivo",
+	"agent_command.version_required":    "é obrigatório em update_agent",
+	"agent_command.version_not_allowed": "só é aceito por update_agent",
+	"agent_command.ttl_exceeded":        "não pode ser negativa nem passar do máximo configurado",
+
+	"restore_request.created":   "Restauração solicitada",
+	"restore_request.found":     "Pedido de restauração encontrado",
+	"restore_request.list":      "Lista de pedidos de restauração",
+	"restore_request.approved":  "Pedido de restauração aprovado",
+	"restore_request.rejected":  "Pedido de restauração rejeitado",
+	"restore_request.started":   "Restauração iniciada",
+	"restore_request.completed": "Resultado da restauração registrado",
+
+	"restore_point.reported":            "Pontos de restauração registrados",
+	"restore_point.list":                "Lista de pontos de restauração",
+	"restore_point.deleted":             "Ponto de restauração excluído",
+	"restore_point.size_history":        "Histórico de tamanho dos backups",
+	"restore_point.size_alerts":         "Lista de alertas de tamanho",
+	"restore_point.invalid_type":        "deve ser full, incremental ou differential",
+	"restore_point.invalid_size":        "deve ser um inteiro não negativo de até 9223372036854775807 bytes",
+	"restore_point.location_required":   "é obrigatório",
+	"restore_point.created_at_required": "é obrigatório",
+	"restore_point.not_found":           "não existe",
+	"restore_point.other_plan":          "pertence a outro plano",
+	"restore_point.expired":             "está vencido",
+
+	"verification.schedule_saved":        "Agenda de verificação salva",
+	"verification.schedule_found":        "Agenda de verificação encontrada",
+	"verification.schedule_deleted":      "Agenda de verificação excluída",
+	"verification.job_list":              "Lista de verificações",
+	"verification.completed":             "Resultado da verificação registrado",
+	"verification.report":                "Relatório de verificações",
+	"verification.invalid_kind":          "deve ser verify ou restore_test",
+	"verification.invalid_interval":      "deve ser de ao menos um dia",
+	"verification.invalid_grace":         "não pode ser negativa",
+	"verification.point_before_schedule": "deve ter sido criado a partir do horário agendado da verificação",
+
+	"validation.required":   "O campo '%s' é obrigatório",
+	"validation.email":      "O campo '%s' deve ser um email válido",
+	"validation.oneof":      "O campo '%s' deve ser um dos valores permitidos: %s",
+	"validation.uuid":       "O campo '%s' deve conter um UUID válido",
+	"validation.uuid4":      "O campo '%s' deve conter um UUID v4 válido",
+	"validation.min":        "O campo '%s' deve ter no mínimo '%s' caracteres",
+	"validation.max":        "O campo '%s' deve ter no máximo '%s' caracteres",
+	"validation.len":        "O campo '%s' deve ter exatamente '%s' caracteres",
+	"validation.min_items":  "O campo '%s' deve ter no mínimo '%s' itens",
+	"validation.max_items":  "O campo '%s' deve ter no máximo '%s' itens",
+	"validation.len_items":  "O campo '%s' deve ter exatamente '%s' itens",
+	"validation.min_number": "O campo '%s' deve ser no mínimo '%s'",
+	"validation.max_number": "O campo '%s' deve ser no máximo '%s'",
+	"validation.len_number": "O campo '%s' deve ser igual a '%s'",
+	"validation.gte":        "O campo '%s' deve ser maior ou igual a '%s'",
+	"validation.lte":        "O campo '%s' deve ser menor ou igual a '%s'",
+	"validation.gt":         "O campo '%s' deve ser maior que '%s'",
+	"validation.lt":         "O campo '%s' deve ser menor que '%s'",
+	"validation.alphanum":   "O campo '%s' deve conter apenas caracteres alfanuméricos",
+	"validation.numeric":    "O campo '%s' deve ser numérico",
+	"validation.url":        "O campo '%s' deve ser uma URL válida",
+	"validation.datetime":   "O campo '%s' deve seguir o formato de data/hora '%s'",
+	"validation.default":    "O campo '%s' é inválido (%s)",
+}
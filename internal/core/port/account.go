@@ -0,0 +1,23 @@
+package port
+
+import (
+	"context"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type UserTokenRepository interface {
+	CreateUserToken(ctx context.Context, token *domain.UserToken) error
+	FindUserToken(ctx context.Context, purpose domain.UserTokenPurpose, tokenHash string) (uuid.UUID, error)
+	ConsumeUserToken(ctx context.Context, purpose domain.UserTokenPurpose, tokenHash string) (uuid.UUID, error)
+	DeleteUserTokens(ctx context.Context, userID uuid.UUID, purpose domain.UserTokenPurpose) error
+}
+
+type AccountService interface {
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, password string) error
+	SendEmailVerification(ctx context.Context, user *domain.User) error
+	ResendEmailVerification(ctx context.Context, email string) error
+	VerifyEmail(ctx context.Context, token string) error
+}
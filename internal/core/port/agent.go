@@ -0,0 +1,48 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type AgentTokenRepository interface {
+	// SaveAgentToken substitui o token atual do dispositivo, se houver.
+	SaveAgentToken(ctx context.Context, token *domain.AgentToken) error
+	GetAgentTokenByHash(ctx context.Context, tokenHash string) (*domain.AgentToken, error)
+	TouchAgentToken(ctx context.Context, id uuid.UUID) error
+	DeleteAgentToken(ctx context.Context, deviceID uuid.UUID) error
+}
+
+type AgentService interface {
+	IssueAgentToken(ctx context.Context, deviceID uuid.UUID) (string, error)
+	RevokeAgentToken(ctx context.Context, deviceID uuid.UUID) error
+	Authenticate(ctx context.Context, rawToken string) (*domain.Device, error)
+	GetAgentConfig(ctx context.Context, deviceID uuid.UUID) (*domain.AgentConfig, error)
+}
+
+type AgentCommandRepository interface {
+	CreateAgentCommand(ctx context.Context, command *domain.AgentCommand) error
+	GetAgentCommandByID(ctx context.Context, id uuid.UUID) (*domain.AgentCommand, error)
+	ListAgentCommands(ctx context.Context, deviceID uuid.UUID, page, limit int) ([]domain.AgentCommand, error)
+	ListPendingAgentCommands(ctx context.Context, deviceID uuid.UUID) ([]domain.AgentCommand, error)
+	// UpdateAgentCommand só grava se o status atual ainda for from, para que
+	// duas respostas concorrentes do agente não se sobreponham.
+	UpdateAgentCommand(ctx context.Context, command *domain.AgentCommand, from domain.AgentCommandStatus) error
+	ExpireAgentCommands(ctx context.Context, deviceID uuid.UUID) error
+	CountAgentCommandsByStatus(ctx context.Context) (map[domain.AgentCommandStatus]int, error)
+}
+
+type AgentCommandService interface {
+	// CreateAgentCommand usa a validade padrão quando ttl é zero.
+	CreateAgentCommand(ctx context.Context, command *domain.AgentCommand, ttl time.Duration) error
+	GetAgentCommand(ctx context.Context, deviceID, id uuid.UUID) (*domain.AgentCommand, error)
+	ListAgentCommands(ctx context.Context, deviceID uuid.UUID, page, limit int) ([]domain.AgentCommand, error)
+	// FetchAgentCommands espera até wait (limitado pela configuração) por
+	// comandos pendentes do dispositivo.
+	FetchAgentCommands(ctx context.Context, deviceID uuid.UUID, wait time.Duration) ([]domain.AgentCommand, error)
+	AcknowledgeAgentCommand(ctx context.Context, deviceID, id uuid.UUID) (*domain.AgentCommand, error)
+	CompleteAgentCommand(ctx context.Context, deviceID, id uuid.UUID, success bool, result string) (*domain.AgentCommand, error)
+}
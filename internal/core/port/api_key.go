@@ -0,0 +1,27 @@
+package port
+
+import (
+	"context"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key *domain.APIKey) error
+	GetAPIKeyByID(ctx context.Context, id uuid.UUID) (*domain.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*domain.APIKey, error)
+	ListAPIKeysByUser(ctx context.Context, userID uuid.UUID) ([]domain.APIKey, error)
+	ListAPIKeys(ctx context.Context, page, limit int) ([]domain.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+	TouchAPIKey(ctx context.Context, id uuid.UUID) error
+}
+
+type APIKeyService interface {
+	CreateAPIKey(ctx context.Context, payload *domain.TokenPayload, key *domain.APIKey) (string, error)
+	ListUserAPIKeys(ctx context.Context, userID uuid.UUID) ([]domain.APIKey, error)
+	RevokeUserAPIKey(ctx context.Context, userID, id uuid.UUID) error
+	ListAPIKeys(ctx context.Context, page, limit int) ([]domain.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+	Authenticate(ctx context.Context, rawKey string) (*domain.TokenPayload, error)
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/google/uuid"
 )
 
 type TokenService interface {
-	CreateToken(user *domain.User) (string, error)
+	CreateToken(user *domain.User, mfa bool) (*domain.IssuedToken, error)
 	VerifyToken(token string) (*domain.TokenPayload, error)
+	CreateMFAChallenge(user *domain.User) (string, error)
+	VerifyMFAChallenge(token string) (uuid.UUID, error)
 }
 
 type AuthService interface {
-	Login(ctx context.Context, username, password string) (string, error)
+	Login(ctx context.Context, username, password string) (*domain.LoginResult, error)
+	VerifyMFA(ctx context.Context, challengeToken, code string) (string, error)
 }
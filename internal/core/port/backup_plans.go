@@ -7,18 +7,32 @@ import (
 	"github.com/google/uuid"
 )
 
+// StorageCapacityCheck recebe o destino do plano e os planos já gravados
+// nele, incluindo a versão anterior do próprio plano na alteração, e devolve
+// erro para impedir a gravação.
+type StorageCapacityCheck func(ctx context.Context, target *domain.StorageTarget, plans []domain.StorageTargetPlan) error
+
 type BackupPlanRepository interface {
-	CreateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan) error
+	// CreateBackupPlan e UpdateBackupPlan, com check informado e o plano
+	// apontando para um destino, bloqueiam o destino (SELECT ... FOR UPDATE)
+	// e rodam check na mesma transação da gravação, para que dois planos
+	// simultâneos não passem juntos da capacidade.
+	CreateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan, check StorageCapacityCheck) error
 	GetBackupPlanByID(ctx context.Context, id uuid.UUID) (*domain.BackupPlan, error)
 	ListBackupPlans(ctx context.Context, page, limit int) ([]domain.BackupPlan, error)
-	UpdateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan) error
+	ListBackupPlansByDevice(ctx context.Context, deviceID uuid.UUID) ([]domain.BackupPlan, error)
+	CountBackupPlans(ctx context.Context) (int, error)
+	UpdateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan, check StorageCapacityCheck) error
 	DeleteBackupPlan(ctx context.Context, id uuid.UUID) error
 }
 
 type BackupPlanService interface {
-	CreateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan) error
+	// CreateBackupPlan e UpdateBackupPlan recusam planos que ultrapassem a
+	// capacidade do destino, a menos que force seja verdadeiro. Na alteração,
+	// detach remove a política de retenção ou o destino do plano.
+	CreateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan, force bool) error
 	GetBackupPlan(ctx context.Context, id uuid.UUID) (*domain.BackupPlan, error)
 	ListBackupPlans(ctx context.Context, page, limit int) ([]domain.BackupPlan, error)
-	UpdateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan) error
+	UpdateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan, detach domain.BackupPlanDetach, force bool) error
 	DeleteBackupPlan(ctx context.Context, id uuid.UUID) error
 }
@@ -12,6 +12,7 @@ type CustomerRepository interface {
 	GetCustomerByID(ctx context.Context, id uuid.UUID) (*domain.Customer, error)
 	GetCustomerByName(ctx context.Context, name string) (*domain.Customer, error)
 	ListCustomers(ctx context.Context, page, limit int) ([]domain.Customer, error)
+	CountCustomers(ctx context.Context) (int, error)
 	UpdateCustomer(ctx context.Context, customer *domain.Customer) error
 	DeleteCustomer(ctx context.Context, id uuid.UUID) error
 }
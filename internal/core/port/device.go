@@ -12,6 +12,7 @@ type DeviceRepository interface {
 	GetDeviceByID(ctx context.Context, id uuid.UUID) (*domain.Device, error)
 	GetDeviceByCustomerID(ctx context.Context, id uuid.UUID) (*domain.Device, error)
 	ListDevices(ctx context.Context, page, limit int) ([]domain.Device, error)
+	CountDevices(ctx context.Context) (int, error)
 	UpdateDevice(ctx context.Context, device *domain.Device) error
 	DeleteDevice(ctx context.Context, id uuid.UUID) error
 }
@@ -0,0 +1,30 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type TOTPService interface {
+	GenerateSecret() (string, error)
+	URI(secret, account string) string
+	// Validate recusa códigos de passos até lastStep, já usados.
+	Validate(secret, code string, at time.Time, lastStep int64) (step int64, ok bool)
+}
+
+type MFARepository interface {
+	SetTOTPSecret(ctx context.Context, userID uuid.UUID, secret string, recoveryCodeHashes []string) error
+	EnableTOTP(ctx context.Context, userID uuid.UUID, step int64) error
+	UpdateTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error
+	DisableTOTP(ctx context.Context, userID uuid.UUID) error
+}
+
+type MFAService interface {
+	EnrollTOTP(ctx context.Context, userID uuid.UUID) (*domain.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, userID uuid.UUID, code string) error
+	DisableTOTP(ctx context.Context, userID uuid.UUID, code string) error
+}
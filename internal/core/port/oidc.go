@@ -0,0 +1,24 @@
+package port
+
+import (
+	"context"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type OIDCProvider interface {
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*domain.OIDCIdentity, error)
+}
+
+type OIDCStateRepository interface {
+	CreateOIDCState(ctx context.Context, state *domain.OIDCState) error
+	ConsumeOIDCState(ctx context.Context, stateHash string) (*domain.OIDCState, error)
+}
+
+type OIDCService interface {
+	Login(ctx context.Context) (*domain.OIDCLogin, error)
+	Link(ctx context.Context, userID uuid.UUID) (*domain.OIDCLogin, error)
+	Callback(ctx context.Context, state, code string) (*domain.LoginResult, error)
+}
@@ -0,0 +1,26 @@
+package port
+
+import (
+	"context"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	Verify(hash, password string) (ok bool, needsRehash bool, err error)
+}
+
+type BreachedPasswordChecker interface {
+	IsBreached(password string) bool
+}
+
+type PasswordService interface {
+	Validate(ctx context.Context, user *domain.User, password string) error
+	Hash(ctx context.Context, password string) (string, error)
+	Verify(ctx context.Context, user *domain.User, password string) (bool, error)
+	SetPassword(ctx context.Context, user *domain.User, password string) error
+	ChangePassword(ctx context.Context, userID, sessionID uuid.UUID, currentPassword, newPassword string) error
+	ForcePasswordChange(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+)
+
+type RateLimiter interface {
+	// Allow consome um token da chave. Quando não há tokens disponíveis
+	// devolve allowed=false e quanto tempo falta para o próximo.
+	Allow(ctx context.Context, key string, limit domain.RateLimit) (allowed bool, retryAfter time.Duration, err error)
+	// Sweep descarta os buckets que não precisam mais ser guardados. É
+	// chamado periodicamente em segundo plano.
+	Sweep(ctx context.Context) error
+}
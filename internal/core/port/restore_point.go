@@ -0,0 +1,52 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type RestorePointRepository interface {
+	// SaveRestorePoints grava os pontos informados; reenviar um ponto atualiza
+	// os dados, mas um ID já usado por outro plano é recusado.
+	SaveRestorePoints(ctx context.Context, points []domain.RestorePoint) error
+	GetRestorePointByID(ctx context.Context, id uuid.UUID) (*domain.RestorePoint, error)
+	ListRestorePoints(ctx context.Context, backupPlanID uuid.UUID, page, limit int) ([]domain.RestorePoint, error)
+	// ListRestorePointHistory devolve todos os pontos do plano em ordem de
+	// criação.
+	ListRestorePointHistory(ctx context.Context, backupPlanID uuid.UUID) ([]domain.RestorePoint, error)
+	// ListLiveRestorePoints devolve os pontos do plano ainda não vencidos em now.
+	ListLiveRestorePoints(ctx context.Context, backupPlanID uuid.UUID, now time.Time) ([]domain.RestorePoint, error)
+	ListExpiredRestorePoints(ctx context.Context, backupPlanID uuid.UUID, now time.Time) ([]domain.RestorePoint, error)
+	// ListDeviceRestorePoints devolve, para cada plano do dispositivo, o ponto
+	// não vencido mais recente criado até at.
+	ListDeviceRestorePoints(ctx context.Context, deviceID uuid.UUID, at time.Time) ([]domain.RestorePoint, error)
+	UpdateRestorePointExpiries(ctx context.Context, points []domain.RestorePoint) error
+	DeleteRestorePoint(ctx context.Context, id uuid.UUID) error
+}
+
+type RestorePointService interface {
+	// ReportRestorePoints registra os pontos informados pelo agente, gera os
+	// alertas de tamanho dos novos pontos, recalcula a validade dos pontos do
+	// plano e devolve os vencidos, que o agente pode apagar do destino.
+	ReportRestorePoints(ctx context.Context, deviceID, backupPlanID uuid.UUID, points []domain.RestorePoint) ([]domain.RestorePoint, error)
+	ListRestorePoints(ctx context.Context, backupPlanID uuid.UUID, page, limit int) ([]domain.RestorePoint, error)
+	ListDeviceRestorePoints(ctx context.Context, deviceID uuid.UUID, at time.Time) ([]domain.RestorePoint, error)
+	// DeleteAgentRestorePoint remove do catálogo um ponto apagado pelo agente.
+	DeleteAgentRestorePoint(ctx context.Context, deviceID, id uuid.UUID) error
+	// GetSizeHistory devolve o tamanho dos backups do plano com a linha de
+	// base e o alerta de cada um.
+	GetSizeHistory(ctx context.Context, backupPlanID uuid.UUID) ([]domain.SizeHistoryEntry, error)
+	ListSizeAlerts(ctx context.Context, severity domain.SizeAlertSeverity, page, limit int) ([]domain.SizeAlert, error)
+}
+
+type SizeAlertRepository interface {
+	// SaveSizeAlerts ignora alertas de pontos que já têm alerta, para que
+	// reenviar um relatório não duplique alertas.
+	SaveSizeAlerts(ctx context.Context, alerts []domain.SizeAlert) error
+	// ListSizeAlerts lista os alertas mais recentes primeiro; severity vazio
+	// não filtra.
+	ListSizeAlerts(ctx context.Context, severity domain.SizeAlertSeverity, page, limit int) ([]domain.SizeAlert, error)
+}
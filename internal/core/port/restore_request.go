@@ -0,0 +1,31 @@
+package port
+
+import (
+	"context"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type RestoreRequestRepository interface {
+	CreateRestoreRequest(ctx context.Context, request *domain.RestoreRequest) error
+	GetRestoreRequestByID(ctx context.Context, id uuid.UUID) (*domain.RestoreRequest, error)
+	// ListRestoreRequests com status vazio lista todos os pedidos.
+	ListRestoreRequests(ctx context.Context, status domain.RestoreRequestStatus, page, limit int) ([]domain.RestoreRequest, error)
+	ListRestoreRequestsByDevice(ctx context.Context, deviceID uuid.UUID, status domain.RestoreRequestStatus) ([]domain.RestoreRequest, error)
+	// UpdateRestoreRequest só grava se o status atual ainda for from.
+	UpdateRestoreRequest(ctx context.Context, request *domain.RestoreRequest, from domain.RestoreRequestStatus) error
+	CountRestoreRequestsByStatus(ctx context.Context) (map[domain.RestoreRequestStatus]int, error)
+}
+
+type RestoreRequestService interface {
+	CreateRestoreRequest(ctx context.Context, request *domain.RestoreRequest) error
+	GetRestoreRequest(ctx context.Context, id uuid.UUID) (*domain.RestoreRequest, error)
+	ListRestoreRequests(ctx context.Context, status domain.RestoreRequestStatus, page, limit int) ([]domain.RestoreRequest, error)
+	ReviewRestoreRequest(ctx context.Context, reviewerID, id uuid.UUID, approve bool, note string) (*domain.RestoreRequest, error)
+	// ListAgentRestores devolve os pedidos aprovados que o agente do
+	// dispositivo ainda não iniciou.
+	ListAgentRestores(ctx context.Context, deviceID uuid.UUID) ([]domain.RestoreRequest, error)
+	StartRestore(ctx context.Context, deviceID, id uuid.UUID) (*domain.RestoreRequest, error)
+	CompleteRestore(ctx context.Context, deviceID, id uuid.UUID, success bool, result string) (*domain.RestoreRequest, error)
+}
@@ -0,0 +1,28 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type RetentionPolicyRepository interface {
+	CreateRetentionPolicy(ctx context.Context, policy *domain.RetentionPolicy) error
+	GetRetentionPolicyByID(ctx context.Context, id uuid.UUID) (*domain.RetentionPolicy, error)
+	ListRetentionPolicies(ctx context.Context, customerID uuid.UUID, page, limit int) ([]domain.RetentionPolicy, error)
+	UpdateRetentionPolicy(ctx context.Context, policy *domain.RetentionPolicy) error
+	DeleteRetentionPolicy(ctx context.Context, id uuid.UUID) error
+	CountBackupPlansByRetentionPolicy(ctx context.Context, id uuid.UUID) (int, error)
+}
+
+type RetentionPolicyService interface {
+	CreateRetentionPolicy(ctx context.Context, policy *domain.RetentionPolicy) error
+	GetRetentionPolicy(ctx context.Context, id uuid.UUID) (*domain.RetentionPolicy, error)
+	ListRetentionPolicies(ctx context.Context, customerID uuid.UUID, page, limit int) ([]domain.RetentionPolicy, error)
+	UpdateRetentionPolicy(ctx context.Context, policy *domain.RetentionPolicy) error
+	DeleteRetentionPolicy(ctx context.Context, id uuid.UUID) error
+	EvaluateRetentionPolicy(ctx context.Context, id uuid.UUID, points []domain.RestorePoint, now time.Time) ([]domain.RetentionDecision, error)
+	EvaluateBackupPlanRetention(ctx context.Context, backupPlanID uuid.UUID, points []domain.RestorePoint, now time.Time) ([]domain.RetentionDecision, error)
+}
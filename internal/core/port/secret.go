@@ -0,0 +1,24 @@
+package port
+
+import (
+	"context"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type SecretRepository interface {
+	GetSecretByID(ctx context.Context, id uuid.UUID) (*domain.Secret, error)
+	// ListSecretsToRotate devolve, em ordem de id e a partir de after, os
+	// segredos que não estão cifrados pela chave keyID.
+	ListSecretsToRotate(ctx context.Context, keyID string, after uuid.UUID, limit int) ([]domain.Secret, error)
+	UpdateSecret(ctx context.Context, secret *domain.Secret) error
+}
+
+// SecretService cifra e decifra segredos com a chave mestra configurada. Os
+// repositórios só recebem o resultado de Seal.
+type SecretService interface {
+	Seal(ctx context.Context, id uuid.UUID, plaintext []byte) (*domain.Secret, error)
+	Open(ctx context.Context, secret *domain.Secret) ([]byte, error)
+	RotateMasterKey(ctx context.Context) (*domain.SecretRotation, error)
+}
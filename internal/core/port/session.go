@@ -0,0 +1,27 @@
+package port
+
+import (
+	"context"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type SessionRepository interface {
+	CreateSession(ctx context.Context, session *domain.Session) error
+	GetSessionByID(ctx context.Context, id uuid.UUID) (*domain.Session, error)
+	ListActiveSessionsByUser(ctx context.Context, userID uuid.UUID) ([]domain.Session, error)
+	RevokeSession(ctx context.Context, id uuid.UUID) error
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID) error
+	RevokeOtherUserSessions(ctx context.Context, userID, keepID uuid.UUID) error
+	TouchSession(ctx context.Context, id uuid.UUID) error
+}
+
+type SessionService interface {
+	Issue(ctx context.Context, user *domain.User, mfa bool) (string, error)
+	Validate(ctx context.Context, payload *domain.TokenPayload) error
+	ListUserSessions(ctx context.Context, userID uuid.UUID) ([]domain.Session, error)
+	RevokeUserSession(ctx context.Context, userID, id uuid.UUID) error
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID) error
+	RevokeOtherUserSessions(ctx context.Context, userID, keepID uuid.UUID) error
+}
@@ -0,0 +1,28 @@
+package port
+
+import (
+	"context"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type StorageTargetRepository interface {
+	// CreateStorageTarget e UpdateStorageTarget gravam credentials, quando
+	// informado, na mesma transação do destino.
+	CreateStorageTarget(ctx context.Context, target *domain.StorageTarget, credentials *domain.Secret) error
+	GetStorageTargetByID(ctx context.Context, id uuid.UUID) (*domain.StorageTarget, error)
+	ListStorageTargets(ctx context.Context, customerID uuid.UUID, page, limit int) ([]domain.StorageTarget, error)
+	UpdateStorageTarget(ctx context.Context, target *domain.StorageTarget, credentials *domain.Secret) error
+	DeleteStorageTarget(ctx context.Context, id uuid.UUID) error
+	ListStorageTargetPlans(ctx context.Context, id uuid.UUID) ([]domain.StorageTargetPlan, error)
+}
+
+type StorageTargetService interface {
+	CreateStorageTarget(ctx context.Context, target *domain.StorageTarget) error
+	GetStorageTarget(ctx context.Context, id uuid.UUID) (*domain.StorageTarget, error)
+	ListStorageTargets(ctx context.Context, customerID uuid.UUID, page, limit int) ([]domain.StorageTarget, error)
+	UpdateStorageTarget(ctx context.Context, target *domain.StorageTarget) error
+	DeleteStorageTarget(ctx context.Context, id uuid.UUID) error
+	GetStorageTargetUsage(ctx context.Context, id uuid.UUID) (*domain.StorageTargetUsage, error)
+}
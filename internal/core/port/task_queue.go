@@ -0,0 +1,9 @@
+package port
+
+import "context"
+
+type TaskQueue interface {
+	// Enqueue agenda fn para rodar fora da requisição. Devolve erro quando a
+	// fila está cheia ou já foi encerrada; nesse caso fn não é executada.
+	Enqueue(ctx context.Context, task string, fn func(ctx context.Context) error) error
+}
@@ -2,6 +2,7 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
 	"github.com/google/uuid"
@@ -15,6 +16,17 @@ type UserRepository interface {
 	ListUsers(ctx context.Context, page, limit int) ([]domain.User, error)
 	UpdateUser(ctx context.Context, user *domain.User) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	IncrementFailedLogins(ctx context.Context, id uuid.UUID) (int, error)
+	LockUser(ctx context.Context, id uuid.UUID, until time.Time) error
+	ResetFailedLogins(ctx context.Context, id uuid.UUID) error
+	ChangePassword(ctx context.Context, id uuid.UUID, password string, historySize int) error
+	RehashPassword(ctx context.Context, id uuid.UUID, password string) error
+	SetMustChangePassword(ctx context.Context, id uuid.UUID, mustChange bool) error
+	ListPasswordHistory(ctx context.Context, id uuid.UUID, limit int) ([]string, error)
+	MarkEmailVerified(ctx context.Context, id uuid.UUID) error
+	GetUserByOIDCSubject(ctx context.Context, subject string) (*domain.User, error)
+	LinkOIDCSubject(ctx context.Context, id uuid.UUID, subject string) error
+	SetUserRole(ctx context.Context, id uuid.UUID, role domain.UserRole) error
 }
 
 type UserService interface {
@@ -23,4 +35,5 @@ type UserService interface {
 	ListUsers(ctx context.Context, page, limit int) ([]domain.User, error)
 	UpdateUser(ctx context.Context, user *domain.User) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	UnlockUser(ctx context.Context, id uuid.UUID) error
 }
@@ -0,0 +1,52 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type VerificationRepository interface {
+	// SaveVerificationSchedule cria ou substitui a agenda do plano.
+	SaveVerificationSchedule(ctx context.Context, schedule *domain.VerificationSchedule) error
+	GetVerificationSchedule(ctx context.Context, backupPlanID uuid.UUID) (*domain.VerificationSchedule, error)
+	DeleteVerificationSchedule(ctx context.Context, backupPlanID uuid.UUID) error
+	// ListVerificationStatuses devolve a agenda, o nome do plano e a última
+	// verificação bem-sucedida dos planos informados que têm agenda; sem IDs,
+	// devolve todos. Vencimento e atraso ficam a cargo do serviço.
+	ListVerificationStatuses(ctx context.Context, backupPlanIDs []uuid.UUID) ([]domain.VerificationStatus, error)
+	// CreateVerificationJob devolve domain.ErrConflictingData se o plano já
+	// tiver uma verificação pendente.
+	CreateVerificationJob(ctx context.Context, job *domain.VerificationJob) error
+	GetVerificationJobByID(ctx context.Context, id uuid.UUID) (*domain.VerificationJob, error)
+	ListPendingVerificationJobs(ctx context.Context, backupPlanIDs []uuid.UUID) ([]domain.VerificationJob, error)
+	ListVerificationJobs(ctx context.Context, backupPlanID uuid.UUID, page, limit int) ([]domain.VerificationJob, error)
+	// UpdateVerificationJob grava o resultado apenas se a verificação ainda
+	// estiver no status from.
+	UpdateVerificationJob(ctx context.Context, job *domain.VerificationJob, from domain.VerificationJobStatus) error
+	CountVerificationJobsByStatus(ctx context.Context) (map[domain.VerificationJobStatus]int, error)
+}
+
+type VerificationService interface {
+	SetVerificationSchedule(ctx context.Context, schedule *domain.VerificationSchedule) error
+	GetVerificationSchedule(ctx context.Context, backupPlanID uuid.UUID) (*domain.VerificationSchedule, error)
+	DeleteVerificationSchedule(ctx context.Context, backupPlanID uuid.UUID) error
+	ListVerificationJobs(ctx context.Context, backupPlanID uuid.UUID, page, limit int) ([]domain.VerificationJob, error)
+	// ListAgentVerificationJobs devolve as verificações pendentes dos planos
+	// do dispositivo.
+	ListAgentVerificationJobs(ctx context.Context, deviceID uuid.UUID) ([]domain.VerificationJob, error)
+	// GenerateVerificationJobs cria as verificações dos planos vencidos que
+	// ainda não têm uma pendente, agendadas para o próximo horário de backup.
+	// Roda periodicamente em segundo plano; falhas de um plano são apenas
+	// registradas no log e só erros que afetam toda a execução são devolvidos.
+	GenerateVerificationJobs(ctx context.Context) error
+	// CompleteVerificationJob registra o resultado da verificação contra o
+	// ponto de restauração verificado, que precisa ser do plano, estar válido
+	// e ter sido criado a partir do horário agendado.
+	CompleteVerificationJob(ctx context.Context, deviceID, id, restorePointID uuid.UUID, success bool, result string) (*domain.VerificationJob, error)
+	// VerificationReport devolve a situação dos planos com agenda, os
+	// atrasados primeiro; onlyOverdue filtra apenas os atrasados.
+	VerificationReport(ctx context.Context, onlyOverdue bool, now time.Time) ([]domain.VerificationStatus, error)
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+)
+
+type accountService struct {
+	userRepo  port.UserRepository
+	tokenRepo port.UserTokenRepository
+	passwords port.PasswordService
+	sessions  port.SessionService
+	mailer    port.Mailer
+	tasks     port.TaskQueue
+	policy    domain.AccountPolicy
+}
+
+func NewAccountService(userRepo port.UserRepository, tokenRepo port.UserTokenRepository, passwords port.PasswordService, sessions port.SessionService, mailer port.Mailer, tasks port.TaskQueue, policy domain.AccountPolicy) port.AccountService {
+	return &accountService{
+		userRepo,
+		tokenRepo,
+		passwords,
+		sessions,
+		mailer,
+		tasks,
+		policy,
+	}
+}
+
+// ForgotPassword não informa se o e-mail está cadastrado: o token é gerado e
+// enviado pela fila de tarefas, para que o tempo de resposta não dependa
+// disso, e falhas ficam apenas no log.
+func (as *accountService) ForgotPassword(ctx context.Context, email string) error {
+	ctx, span := tracer.Start(ctx, "accountService.ForgotPassword")
+	defer span.End()
+
+	user, err := as.userRepo.GetUserByEmail(ctx, email)
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	as.enqueue(ctx, "envio de redefinição de senha", func(ctx context.Context) error {
+		return as.sendPasswordReset(ctx, user)
+	})
+
+	return nil
+}
+
+func (as *accountService) sendPasswordReset(ctx context.Context, user *domain.User) error {
+	err := as.tokenRepo.DeleteUserTokens(ctx, user.ID, domain.PasswordReset)
+	if err != nil {
+		return err
+	}
+
+	token, err := as.issueToken(ctx, user.ID, domain.PasswordReset, as.policy.PasswordResetTTL)
+	if err != nil {
+		return err
+	}
+
+	lang := i18n.FromContext(ctx)
+	as.send(ctx, domain.Email{
+		To:      user.Email,
+		Subject: i18n.T(lang, "mail.password_reset.subject"),
+		Body:    i18n.T(lang, "mail.password_reset.body", user.Fullname, as.link("/reset-password", token), formatTTL(lang, as.policy.PasswordResetTTL)),
+	})
+
+	return nil
+}
+
+func (as *accountService) ResetPassword(ctx context.Context, token, password string) error {
+	ctx, span := tracer.Start(ctx, "accountService.ResetPassword")
+	defer span.End()
+
+	tokenHash := hashUserToken(token)
+
+	userID, err := as.tokenRepo.FindUserToken(ctx, domain.PasswordReset, tokenHash)
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return domain.ErrInvalidUserToken
+	}
+	if err != nil {
+		return err
+	}
+
+	user, err := as.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// A senha é validada antes de consumir o token, para que uma senha
+	// recusada pela política não obrigue o usuário a pedir outro link.
+	err = as.passwords.Validate(ctx, user, password)
+	if err != nil {
+		return err
+	}
+
+	_, err = as.tokenRepo.ConsumeUserToken(ctx, domain.PasswordReset, tokenHash)
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return domain.ErrInvalidUserToken
+	}
+	if err != nil {
+		return err
+	}
+
+	err = as.passwords.SetPassword(ctx, user, password)
+	if err != nil {
+		return err
+	}
+
+	// Sessões abertas com a senha antiga não sobrevivem à redefinição.
+	err = as.sessions.RevokeUserSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// Quem recebeu o link comprovou o acesso ao e-mail, então o bloqueio por
+	// tentativas e a verificação pendente deixam de fazer sentido.
+	err = as.userRepo.ResetFailedLogins(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = as.userRepo.MarkEmailVerified(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return as.tokenRepo.DeleteUserTokens(ctx, userID, domain.PasswordReset)
+}
+
+func (as *accountService) SendEmailVerification(ctx context.Context, user *domain.User) error {
+	ctx, span := tracer.Start(ctx, "accountService.SendEmailVerification")
+	defer span.End()
+
+	err := as.tokenRepo.DeleteUserTokens(ctx, user.ID, domain.EmailVerification)
+	if err != nil {
+		return err
+	}
+
+	token, err := as.issueToken(ctx, user.ID, domain.EmailVerification, as.policy.EmailVerificationTTL)
+	if err != nil {
+		return err
+	}
+
+	lang := i18n.FromContext(ctx)
+	as.send(ctx, domain.Email{
+		To:      user.Email,
+		Subject: i18n.T(lang, "mail.email_verification.subject"),
+		Body:    i18n.T(lang, "mail.email_verification.body", user.Fullname, as.link("/verify-email", token), formatTTL(lang, as.policy.EmailVerificationTTL)),
+	})
+
+	return nil
+}
+
+func (as *accountService) ResendEmailVerification(ctx context.Context, email string) error {
+	ctx, span := tracer.Start(ctx, "accountService.ResendEmailVerification")
+	defer span.End()
+
+	user, err := as.userRepo.GetUserByEmail(ctx, email)
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if user.EmailVerifiedAt != nil {
+		return nil
+	}
+
+	// Assim como em ForgotPassword, o envio não deve revelar pelo tempo de
+	// resposta se o e-mail está cadastrado.
+	as.enqueue(ctx, "reenvio de verificação de e-mail", func(ctx context.Context) error {
+		return as.SendEmailVerification(ctx, user)
+	})
+
+	return nil
+}
+
+func (as *accountService) VerifyEmail(ctx context.Context, token string) error {
+	ctx, span := tracer.Start(ctx, "accountService.VerifyEmail")
+	defer span.End()
+
+	userID, err := as.tokenRepo.ConsumeUserToken(ctx, domain.EmailVerification, hashUserToken(token))
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return domain.ErrInvalidUserToken
+	}
+	if err != nil {
+		return err
+	}
+
+	return as.userRepo.MarkEmailVerified(ctx, userID)
+}
+
+func (as *accountService) issueToken(ctx context.Context, userID uuid.UUID, purpose domain.UserTokenPurpose, ttl time.Duration) (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", domain.ErrInternal.Wrap(err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	err := as.tokenRepo.CreateUserToken(ctx, &domain.UserToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: hashUserToken(token),
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (as *accountService) send(ctx context.Context, email domain.Email) {
+	if err := as.mailer.Send(ctx, email); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao enviar e-mail", "error", err.Error(), "subject", email.Subject)
+	}
+}
+
+// enqueue agenda fn na fila de tarefas. Com a fila cheia o envio é
+// descartado e só aparece no log, sem mudar a resposta da requisição.
+func (as *accountService) enqueue(ctx context.Context, task string, fn func(ctx context.Context) error) {
+	if err := as.tasks.Enqueue(ctx, task, fn); err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao agendar tarefa de conta", "task", task, "error", err.Error())
+	}
+}
+
+func (as *accountService) link(path, token string) string {
+	return strings.TrimRight(as.policy.BaseURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+func hashUserToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func formatTTL(lang i18n.Lang, ttl time.Duration) string {
+	if ttl >= time.Hour && ttl%time.Hour == 0 {
+		return i18n.T(lang, "duration.hours", int(ttl.Hours()))
+	}
+	return i18n.T(lang, "duration.minutes", int(ttl.Minutes()))
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+)
+
+const (
+	agentTokenPrefix        = "dev_"
+	agentTokenDisplayPrefix = 12
+)
+
+type agentService struct {
+	repo           port.AgentTokenRepository
+	deviceRepo     port.DeviceRepository
+	backupPlanRepo port.BackupPlanRepository
+	retentionRepo  port.RetentionPolicyRepository
+	targetRepo     port.StorageTargetRepository
+	secretRepo     port.SecretRepository
+	secrets        port.SecretService
+}
+
+func NewAgentService(repo port.AgentTokenRepository, deviceRepo port.DeviceRepository, backupPlanRepo port.BackupPlanRepository, retentionRepo port.RetentionPolicyRepository, targetRepo port.StorageTargetRepository, secretRepo port.SecretRepository, secrets port.SecretService) port.AgentService {
+	return &agentService{
+		repo,
+		deviceRepo,
+		backupPlanRepo,
+		retentionRepo,
+		targetRepo,
+		secretRepo,
+		secrets,
+	}
+}
+
+// IssueAgentToken devolve o valor do token, que não é armazenado. Um novo
+// token substitui o anterior do dispositivo.
+func (as *agentService) IssueAgentToken(ctx context.Context, deviceID uuid.UUID) (string, error) {
+	ctx, span := tracer.Start(ctx, "agentService.IssueAgentToken")
+	defer span.End()
+
+	_, err := as.deviceRepo.GetDeviceByID(ctx, deviceID)
+	if err != nil {
+		return "", err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", domain.ErrInternal.Wrap(err)
+	}
+	rawToken := agentTokenPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	token := &domain.AgentToken{
+		ID:        uuid.New(),
+		DeviceID:  deviceID,
+		Prefix:    rawToken[:agentTokenDisplayPrefix],
+		TokenHash: hashAgentToken(rawToken),
+	}
+
+	err = as.repo.SaveAgentToken(ctx, token)
+	if err != nil {
+		return "", err
+	}
+
+	return rawToken, nil
+}
+
+func (as *agentService) RevokeAgentToken(ctx context.Context, deviceID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "agentService.RevokeAgentToken")
+	defer span.End()
+
+	return as.repo.DeleteAgentToken(ctx, deviceID)
+}
+
+func (as *agentService) Authenticate(ctx context.Context, rawToken string) (*domain.Device, error) {
+	ctx, span := tracer.Start(ctx, "agentService.Authenticate")
+	defer span.End()
+
+	token, err := as.repo.GetAgentTokenByHash(ctx, hashAgentToken(rawToken))
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return nil, domain.ErrInvalidAgentToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	device, err := as.deviceRepo.GetDeviceByID(ctx, token.DeviceID)
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return nil, domain.ErrInvalidAgentToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := as.repo.TouchAgentToken(ctx, token.ID); err != nil {
+		utils.Logger(ctx).WarnContext(ctx, "Falha ao registrar uso do token do agente", "error", err.Error())
+	}
+
+	return device, nil
+}
+
+// GetAgentConfig monta a configuração com todos os planos do dispositivo;
+// planos não têm estado de ativação, então todo plano gravado é executado.
+func (as *agentService) GetAgentConfig(ctx context.Context, deviceID uuid.UUID) (*domain.AgentConfig, error) {
+	ctx, span := tracer.Start(ctx, "agentService.GetAgentConfig")
+	defer span.End()
+
+	backupPlans, err := as.backupPlanRepo.ListBackupPlansByDevice(ctx, deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make(map[uuid.UUID]*domain.RetentionPolicy)
+	targets := make(map[uuid.UUID]*domain.StorageTarget)
+	credentials := make(map[uuid.UUID]*domain.StorageTargetCredentials)
+
+	config := &domain.AgentConfig{
+		DeviceID:    deviceID,
+		GeneratedAt: time.Now(),
+		Plans:       make([]domain.AgentPlan, 0, len(backupPlans)),
+	}
+
+	for _, backupPlan := range backupPlans {
+		plan := domain.AgentPlan{BackupPlan: backupPlan}
+
+		if id := backupPlan.RetentionPolicyID; id != nil {
+			if _, ok := policies[*id]; !ok {
+				policies[*id], err = as.retentionRepo.GetRetentionPolicyByID(ctx, *id)
+				if err != nil {
+					return nil, err
+				}
+			}
+			plan.RetentionPolicy = policies[*id]
+		}
+
+		if id := backupPlan.StorageTargetID; id != nil {
+			if _, ok := targets[*id]; !ok {
+				targets[*id], err = as.targetRepo.GetStorageTargetByID(ctx, *id)
+				if err != nil {
+					return nil, err
+				}
+
+				credentials[*id], err = as.openCredentials(ctx, targets[*id])
+				if err != nil {
+					return nil, err
+				}
+			}
+			plan.StorageTarget = targets[*id]
+			plan.TargetCredentials = credentials[*id]
+		}
+
+		config.Plans = append(config.Plans, plan)
+	}
+
+	config.Version, err = agentConfigVersion(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func (as *agentService) openCredentials(ctx context.Context, target *domain.StorageTarget) (*domain.StorageTargetCredentials, error) {
+	if target.CredentialsSecretID == nil {
+		return nil, nil
+	}
+
+	secret, err := as.secretRepo.GetSecretByID(ctx, *target.CredentialsSecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := as.secrets.Open(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	var credentials domain.StorageTargetCredentials
+	if err := json.Unmarshal(plaintext, &credentials); err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return &credentials, nil
+}
+
+// agentConfigVersion identifica o conteúdo da configuração. As credenciais
+// ficam de fora para não expor um hash delas; trocá-las já altera o
+// updated_at do destino.
+func agentConfigVersion(config *domain.AgentConfig) (string, error) {
+	plans := make([]domain.AgentPlan, len(config.Plans))
+	for i, plan := range config.Plans {
+		plan.TargetCredentials = nil
+		plans[i] = plan
+	}
+
+	data, err := json.Marshal(struct {
+		DeviceID uuid.UUID
+		Plans    []domain.AgentPlan
+	}{config.DeviceID, plans})
+	if err != nil {
+		return "", domain.ErrInternal.Wrap(err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func hashAgentToken(rawToken string) string {
+	sum := sha256.Sum256([]byte(rawToken))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+)
+
+// agentCommandPollInterval é o intervalo entre consultas durante o long-poll.
+const agentCommandPollInterval = 2 * time.Second
+
+type agentCommandService struct {
+	repo           port.AgentCommandRepository
+	deviceRepo     port.DeviceRepository
+	backupPlanRepo port.BackupPlanRepository
+	ttl            time.Duration
+	maxTTL         time.Duration
+	pollWait       time.Duration
+}
+
+func NewAgentCommandService(repo port.AgentCommandRepository, deviceRepo port.DeviceRepository, backupPlanRepo port.BackupPlanRepository, ttl, maxTTL, pollWait time.Duration) port.AgentCommandService {
+	return &agentCommandService{
+		repo,
+		deviceRepo,
+		backupPlanRepo,
+		ttl,
+		maxTTL,
+		pollWait,
+	}
+}
+
+func (acs *agentCommandService) CreateAgentCommand(ctx context.Context, command *domain.AgentCommand, ttl time.Duration) error {
+	ctx, span := tracer.Start(ctx, "agentCommandService.CreateAgentCommand")
+	defer span.End()
+
+	if err := command.Validate(); err != nil {
+		return err
+	}
+
+	if ttl < 0 || ttl > acs.maxTTL {
+		return domain.ErrInvalidAgentCommand.WithDetails(map[string]string{"ttl_seconds": "agent_command.ttl_exceeded"})
+	}
+
+	_, err := acs.deviceRepo.GetDeviceByID(ctx, command.DeviceID)
+	if err != nil {
+		return err
+	}
+
+	if command.BackupPlanID != nil {
+		backupPlan, err := acs.backupPlanRepo.GetBackupPlanByID(ctx, *command.BackupPlanID)
+		if err != nil {
+			return err
+		}
+
+		if backupPlan.DeviceID != command.DeviceID {
+			return domain.ErrInvalidAgentCommand.WithDetails(map[string]string{"backup_plan_id": "agent_command.plan_device"})
+		}
+	}
+
+	command.Status = domain.AgentCommandPending
+	command.ExpiresAt = time.Now().Add(utils.Coalesce(ttl, acs.ttl))
+
+	return acs.repo.CreateAgentCommand(ctx, command)
+}
+
+func (acs *agentCommandService) GetAgentCommand(ctx context.Context, deviceID, id uuid.UUID) (*domain.AgentCommand, error) {
+	ctx, span := tracer.Start(ctx, "agentCommandService.GetAgentCommand")
+	defer span.End()
+
+	if err := acs.repo.ExpireAgentCommands(ctx, deviceID); err != nil {
+		return nil, err
+	}
+
+	return acs.deviceCommand(ctx, deviceID, id)
+}
+
+func (acs *agentCommandService) ListAgentCommands(ctx context.Context, deviceID uuid.UUID, page, limit int) ([]domain.AgentCommand, error) {
+	ctx, span := tracer.Start(ctx, "agentCommandService.ListAgentCommands")
+	defer span.End()
+
+	_, err := acs.deviceRepo.GetDeviceByID(ctx, deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := acs.repo.ExpireAgentCommands(ctx, deviceID); err != nil {
+		return nil, err
+	}
+
+	return acs.repo.ListAgentCommands(ctx, deviceID, page, limit)
+}
+
+// FetchAgentCommands não altera os comandos; o agente confirma cada um com
+// AcknowledgeAgentCommand, e os não confirmados voltam na próxima consulta.
+// Os vencidos já ficam fora da consulta de pendentes, então a espera não
+// grava nada a cada volta; eles são marcados como expirados na listagem.
+func (acs *agentCommandService) FetchAgentCommands(ctx context.Context, deviceID uuid.UUID, wait time.Duration) ([]domain.AgentCommand, error) {
+	ctx, span := tracer.Start(ctx, "agentCommandService.FetchAgentCommands")
+	defer span.End()
+
+	deadline := time.Now().Add(min(wait, acs.pollWait))
+	for {
+		commands, err := acs.repo.ListPendingAgentCommands(ctx, deviceID)
+		if err != nil {
+			return nil, err
+		}
+
+		remaining := time.Until(deadline)
+		if len(commands) > 0 || remaining <= 0 {
+			return commands, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return commands, nil
+		case <-time.After(min(remaining, agentCommandPollInterval)):
+		}
+	}
+}
+
+func (acs *agentCommandService) AcknowledgeAgentCommand(ctx context.Context, deviceID, id uuid.UUID) (*domain.AgentCommand, error) {
+	ctx, span := tracer.Start(ctx, "agentCommandService.AcknowledgeAgentCommand")
+	defer span.End()
+
+	command, err := acs.deviceCommand(ctx, deviceID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	from := command.Status
+	if err := command.Acknowledge(time.Now()); err != nil {
+		return nil, err
+	}
+
+	if from == command.Status {
+		return command, nil
+	}
+
+	err = acs.repo.UpdateAgentCommand(ctx, command, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
+
+func (acs *agentCommandService) CompleteAgentCommand(ctx context.Context, deviceID, id uuid.UUID, success bool, result string) (*domain.AgentCommand, error) {
+	ctx, span := tracer.Start(ctx, "agentCommandService.CompleteAgentCommand")
+	defer span.End()
+
+	command, err := acs.deviceCommand(ctx, deviceID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	from := command.Status
+	if err := command.Complete(success, result, time.Now()); err != nil {
+		return nil, err
+	}
+
+	err = acs.repo.UpdateAgentCommand(ctx, command, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
+
+// deviceCommand esconde comandos de outros dispositivos como inexistentes.
+func (acs *agentCommandService) deviceCommand(ctx context.Context, deviceID, id uuid.UUID) (*domain.AgentCommand, error) {
+	command, err := acs.repo.GetAgentCommandByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if command.DeviceID != deviceID {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return command, nil
+}
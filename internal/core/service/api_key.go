@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+)
+
+const (
+	apiKeyPrefix        = "bkp_"
+	apiKeyDisplayPrefix = 12
+)
+
+type apiKeyService struct {
+	repo     port.APIKeyRepository
+	userRepo port.UserRepository
+}
+
+func NewAPIKeyService(repo port.APIKeyRepository, userRepo port.UserRepository) port.APIKeyService {
+	return &apiKeyService{
+		repo,
+		userRepo,
+	}
+}
+
+// CreateAPIKey devolve o valor da chave, que não é armazenado e não pode ser
+// recuperado depois. Chaves não podem criar outras chaves.
+func (aks *apiKeyService) CreateAPIKey(ctx context.Context, payload *domain.TokenPayload, key *domain.APIKey) (string, error) {
+	ctx, span := tracer.Start(ctx, "apiKeyService.CreateAPIKey")
+	defer span.End()
+
+	if payload.IsAPIKey() {
+		return "", domain.ErrForbidden
+	}
+
+	if key.HasScope(domain.ScopeAdmin) && payload.Role != domain.Admin {
+		return "", domain.ErrForbidden
+	}
+
+	if key.ExpiresAt != nil && !key.ExpiresAt.After(time.Now()) {
+		return "", domain.ErrValidation.WithDetails(map[string]string{
+			"expires_at": i18n.T(i18n.FromContext(ctx), "api_key.expires_in_past"),
+		})
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", domain.ErrInternal.Wrap(err)
+	}
+	rawKey := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	key.ID = uuid.New()
+	key.UserID = payload.UserID
+	key.Prefix = rawKey[:apiKeyDisplayPrefix]
+	key.KeyHash = hashAPIKey(rawKey)
+
+	err := aks.repo.CreateAPIKey(ctx, key)
+	if err != nil {
+		return "", err
+	}
+
+	return rawKey, nil
+}
+
+func (aks *apiKeyService) ListUserAPIKeys(ctx context.Context, userID uuid.UUID) ([]domain.APIKey, error) {
+	ctx, span := tracer.Start(ctx, "apiKeyService.ListUserAPIKeys")
+	defer span.End()
+
+	return aks.repo.ListAPIKeysByUser(ctx, userID)
+}
+
+func (aks *apiKeyService) RevokeUserAPIKey(ctx context.Context, userID, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "apiKeyService.RevokeUserAPIKey")
+	defer span.End()
+
+	key, err := aks.repo.GetAPIKeyByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if key.UserID != userID {
+		return domain.ErrDataNotFound
+	}
+
+	return aks.repo.RevokeAPIKey(ctx, key.ID)
+}
+
+func (aks *apiKeyService) ListAPIKeys(ctx context.Context, page, limit int) ([]domain.APIKey, error) {
+	ctx, span := tracer.Start(ctx, "apiKeyService.ListAPIKeys")
+	defer span.End()
+
+	return aks.repo.ListAPIKeys(ctx, page, limit)
+}
+
+func (aks *apiKeyService) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "apiKeyService.RevokeAPIKey")
+	defer span.End()
+
+	return aks.repo.RevokeAPIKey(ctx, id)
+}
+
+func (aks *apiKeyService) Authenticate(ctx context.Context, rawKey string) (*domain.TokenPayload, error) {
+	ctx, span := tracer.Start(ctx, "apiKeyService.Authenticate")
+	defer span.End()
+
+	key, err := aks.repo.GetAPIKeyByHash(ctx, hashAPIKey(rawKey))
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return nil, domain.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !key.IsActive(time.Now()) {
+		return nil, domain.ErrInvalidAPIKey
+	}
+
+	user, err := aks.userRepo.GetUserByID(ctx, key.UserID)
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return nil, domain.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := aks.repo.TouchAPIKey(ctx, key.ID); err != nil {
+		utils.Logger(ctx).WarnContext(ctx, "Falha ao registrar uso da chave de API", "error", err.Error())
+	}
+
+	return &domain.TokenPayload{
+		ID:                     key.ID,
+		UserID:                 user.ID,
+		Role:                   user.Role,
+		MFA:                    user.MFAEnabled,
+		PasswordChangeRequired: user.MustChangePassword,
+		APIKeyID:               key.ID,
+		Scopes:                 key.Scopes,
+	}, nil
+}
+
+func hashAPIKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(sum[:])
+}
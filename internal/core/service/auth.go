@@ -2,39 +2,203 @@ package service
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
 )
 
 type authService struct {
-	userRepo port.UserRepository
-	authRepo port.TokenService
+	userRepo  port.UserRepository
+	mfaRepo   port.MFARepository
+	passwords port.PasswordService
+	authRepo  port.TokenService
+	sessions  port.SessionService
+	totp      port.TOTPService
+	limiter   port.RateLimiter
+	policy    domain.LoginPolicy
 }
 
-func NewAuthService(userRepo port.UserRepository, authRepo port.TokenService) port.AuthService {
+func NewAuthService(userRepo port.UserRepository, mfaRepo port.MFARepository, passwords port.PasswordService, authRepo port.TokenService, sessions port.SessionService, totp port.TOTPService, limiter port.RateLimiter, policy domain.LoginPolicy) port.AuthService {
 	return &authService{
 		userRepo,
+		mfaRepo,
+		passwords,
 		authRepo,
+		sessions,
+		totp,
+		limiter,
+		policy,
 	}
 }
 
-func (as *authService) Login(ctx context.Context, username, password string) (string, error) {
+// Login valida usuário e senha. Para contas com MFA ativo nenhum token de
+// acesso é emitido aqui, apenas o desafio a ser concluído em VerifyMFA.
+func (as *authService) Login(ctx context.Context, username, password string) (*domain.LoginResult, error) {
+	ctx, span := tracer.Start(ctx, "authService.Login")
+	defer span.End()
+
+	err := as.allow(ctx, "login:username:"+strings.ToLower(username))
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := as.userRepo.GetUserByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			// Mesmo custo de uma senha errada, para não revelar quais
+			// usernames estão cadastrados.
+			as.passwords.Verify(ctx, nil, password)
+			return nil, domain.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	// O bloqueio é verificado antes da senha, para que a resposta não dependa
+	// dela. O custo do hash é o mesmo de sempre e a tentativa conta como
+	// falha, então tentativas durante o bloqueio só o prolongam.
+	now := time.Now()
+	if user.IsLocked(now) {
+		as.passwords.Verify(ctx, nil, password)
+		return nil, as.registerFailure(ctx, user, now, domain.ErrAccountLocked.WithRetryAfter(user.LockedUntil.Sub(now)))
+	}
+
+	ok, err := as.passwords.Verify(ctx, user, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, as.registerFailure(ctx, user, now, domain.ErrInvalidCredentials)
+	}
+
+	if as.policy.RequireEmailVerification && user.EmailVerifiedAt == nil {
+		return nil, domain.ErrEmailNotVerified
+	}
+
+	if user.FailedLoginAttempts > 0 || user.LockedUntil != nil {
+		if err := as.userRepo.ResetFailedLogins(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if user.MFAEnabled {
+		challenge, err := as.authRepo.CreateMFAChallenge(user)
+		if err != nil {
+			return nil, domain.ErrTokenCreation
+		}
+
+		return &domain.LoginResult{
+			MFARequired:    true,
+			ChallengeToken: challenge,
+		}, nil
+	}
+
+	accessToken, err := as.sessions.Issue(ctx, user, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.LoginResult{
+		AccessToken: accessToken,
+	}, nil
+}
+
+func (as *authService) VerifyMFA(ctx context.Context, challengeToken, code string) (string, error) {
+	ctx, span := tracer.Start(ctx, "authService.VerifyMFA")
+	defer span.End()
+
+	userID, err := as.authRepo.VerifyMFAChallenge(challengeToken)
+	if err != nil {
+		return "", err
+	}
+
+	err = as.allow(ctx, "mfa:user:"+userID.String())
 	if err != nil {
 		return "", err
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
+	user, err := as.userRepo.GetUserByID(ctx, userID)
 	if err != nil {
-		return "", domain.ErrInvalidCredentials
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return "", domain.ErrInvalidToken
+		}
+		return "", err
+	}
+
+	now := time.Now()
+	if user.IsLocked(now) {
+		return "", domain.ErrAccountLocked.WithRetryAfter(user.LockedUntil.Sub(now))
+	}
+
+	if !user.MFAEnabled {
+		return "", domain.ErrMFANotEnrolled
+	}
+
+	err = verifySecondFactor(ctx, as.mfaRepo, as.totp, user, code)
+	if errors.Is(err, domain.ErrInvalidMFACode) {
+		return "", as.registerFailure(ctx, user, now, domain.ErrInvalidMFACode)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if user.FailedLoginAttempts > 0 {
+		if err := as.userRepo.ResetFailedLogins(ctx, user.ID); err != nil {
+			return "", err
+		}
 	}
 
-	accessToken, err := as.authRepo.CreateToken(user)
+	accessToken, err := as.sessions.Issue(ctx, user, true)
 	if err != nil {
-		return "", domain.ErrTokenCreation
+		return "", err
 	}
 
 	return accessToken, nil
 }
+
+// allow aplica o limite por conta. Se o armazenamento dos buckets falhar a
+// tentativa segue, já que o bloqueio de conta continua ativo.
+func (as *authService) allow(ctx context.Context, key string) error {
+	allowed, retryAfter, err := as.limiter.Allow(ctx, key, as.policy.UsernameLimit)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao consultar rate limit", "error", err.Error())
+		return nil
+	}
+
+	if !allowed {
+		return domain.ErrTooManyRequests.WithRetryAfter(retryAfter)
+	}
+
+	return nil
+}
+
+// registerFailure conta a falha e, ao atingir o limite da política, bloqueia
+// a conta por um período que cresce a cada nova falha. Um bloqueio em
+// andamento nunca é encurtado.
+func (as *authService) registerFailure(ctx context.Context, user *domain.User, now time.Time, failure error) error {
+	attempts, err := as.userRepo.IncrementFailedLogins(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	duration := as.policy.LockoutDuration(attempts)
+	if duration == 0 {
+		return failure
+	}
+
+	lockedUntil := now.Add(duration)
+	if user.LockedUntil != nil && user.LockedUntil.After(lockedUntil) {
+		lockedUntil = *user.LockedUntil
+	}
+
+	if err := as.userRepo.LockUser(ctx, user.ID, lockedUntil); err != nil {
+		return err
+	}
+
+	utils.Logger(ctx).WarnContext(ctx, "Conta bloqueada por excesso de tentativas", "user_id", user.ID.String(), "attempts", attempts, "duration", lockedUntil.Sub(now).String())
+	return domain.ErrAccountLocked.WithRetryAfter(lockedUntil.Sub(now))
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
@@ -13,21 +15,47 @@ type backupPlanService struct {
 	customerRepo   port.CustomerRepository
 	deviceRepo     port.DeviceRepository
 	backupPlanRepo port.BackupPlanRepository
+	retentionRepo  port.RetentionPolicyRepository
+	targetRepo     port.StorageTargetRepository
+	verifyRepo     port.VerificationRepository
 }
 
 func NewBackupPlanService(
 	customerRepo port.CustomerRepository,
 	deviceRepo port.DeviceRepository,
 	backupPlanRepo port.BackupPlanRepository,
+	retentionRepo port.RetentionPolicyRepository,
+	targetRepo port.StorageTargetRepository,
+	verifyRepo port.VerificationRepository,
 ) port.BackupPlanService {
 	return &backupPlanService{
 		customerRepo,
 		deviceRepo,
 		backupPlanRepo,
+		retentionRepo,
+		targetRepo,
+		verifyRepo,
 	}
 }
 
-func (bps *backupPlanService) CreateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan) error {
+func (bps *backupPlanService) CreateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan, force bool) error {
+	ctx, span := tracer.Start(ctx, "backupPlanService.CreateBackupPlan")
+	defer span.End()
+
+	// Sem opções informadas, o plano é comprimido e não cifrado.
+	if backupPlan.Compression == nil {
+		compression := true
+		backupPlan.Compression = &compression
+	}
+	if backupPlan.Encryption == nil {
+		encryption := false
+		backupPlan.Encryption = &encryption
+	}
+
+	if err := backupPlan.Validate(); err != nil {
+		return err
+	}
+
 	device, err := bps.deviceRepo.GetDeviceByID(ctx, backupPlan.DeviceID)
 	if err != nil {
 		return err
@@ -50,7 +78,12 @@ func (bps *backupPlanService) CreateBackupPlan(ctx context.Context, backupPlan *
 
 	backupPlan.Customer = customer
 
-	err = bps.backupPlanRepo.CreateBackupPlan(ctx, backupPlan)
+	err = bps.checkRetentionPolicy(ctx, backupPlan.RetentionPolicyID, device.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	err = bps.backupPlanRepo.CreateBackupPlan(ctx, backupPlan, bps.storageCapacityCheck(backupPlan, device.CustomerID, force))
 	if err != nil {
 		return err
 	}
@@ -59,6 +92,9 @@ func (bps *backupPlanService) CreateBackupPlan(ctx context.Context, backupPlan *
 }
 
 func (bps *backupPlanService) GetBackupPlan(ctx context.Context, id uuid.UUID) (*domain.BackupPlan, error) {
+	ctx, span := tracer.Start(ctx, "backupPlanService.GetBackupPlan")
+	defer span.End()
+
 	var backupPlan *domain.BackupPlan
 
 	backupPlan, err := bps.backupPlanRepo.GetBackupPlanByID(ctx, id)
@@ -66,10 +102,18 @@ func (bps *backupPlanService) GetBackupPlan(ctx context.Context, id uuid.UUID) (
 		return nil, err
 	}
 
+	err = bps.attachVerification(ctx, []*domain.BackupPlan{backupPlan})
+	if err != nil {
+		return nil, err
+	}
+
 	return backupPlan, nil
 }
 
 func (bps *backupPlanService) ListBackupPlans(ctx context.Context, page, limit int) ([]domain.BackupPlan, error) {
+	ctx, span := tracer.Start(ctx, "backupPlanService.ListBackupPlans")
+	defer span.End()
+
 	var backupPlans []domain.BackupPlan
 
 	backupPlans, err := bps.backupPlanRepo.ListBackupPlans(ctx, page, limit)
@@ -77,20 +121,80 @@ func (bps *backupPlanService) ListBackupPlans(ctx context.Context, page, limit i
 		return nil, err
 	}
 
+	plans := make([]*domain.BackupPlan, len(backupPlans))
+	for i := range backupPlans {
+		plans[i] = &backupPlans[i]
+	}
+
+	err = bps.attachVerification(ctx, plans)
+	if err != nil {
+		return nil, err
+	}
+
 	return backupPlans, nil
 }
 
-func (bps *backupPlanService) UpdateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan) error {
+// attachVerification preenche a situação de verificação dos planos que têm
+// agenda.
+func (bps *backupPlanService) attachVerification(ctx context.Context, backupPlans []*domain.BackupPlan) error {
+	if len(backupPlans) == 0 {
+		return nil
+	}
+
+	backupPlanIDs := make([]uuid.UUID, len(backupPlans))
+	for i, backupPlan := range backupPlans {
+		backupPlanIDs[i] = backupPlan.ID
+	}
+
+	statuses, err := verificationStatuses(ctx, bps.verifyRepo, backupPlanIDs, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, backupPlan := range backupPlans {
+		i := slices.IndexFunc(statuses, func(status domain.VerificationStatus) bool { return status.BackupPlanID == backupPlan.ID })
+		if i >= 0 {
+			backupPlan.Verification = &statuses[i]
+		}
+	}
+
+	return nil
+}
+
+func (bps *backupPlanService) UpdateBackupPlan(ctx context.Context, backupPlan *domain.BackupPlan, detach domain.BackupPlanDetach, force bool) error {
+	ctx, span := tracer.Start(ctx, "backupPlanService.UpdateBackupPlan")
+	defer span.End()
+
 	existingBackupPlan, err := bps.backupPlanRepo.GetBackupPlanByID(ctx, backupPlan.ID)
 	if err != nil {
 		return err
 	}
 
 	updatedBackupPlan := &domain.BackupPlan{
-		ID:              backupPlan.ID,
-		Name:            utils.Coalesce(backupPlan.Name, existingBackupPlan.Name),
-		BackupSizeBytes: utils.Coalesce(backupPlan.BackupSizeBytes, existingBackupPlan.BackupSizeBytes),
-		DeviceID:        utils.Coalesce(backupPlan.DeviceID, existingBackupPlan.DeviceID),
+		ID:                backupPlan.ID,
+		Name:              utils.Coalesce(backupPlan.Name, existingBackupPlan.Name),
+		BackupSizeBytes:   utils.Coalesce(backupPlan.BackupSizeBytes, existingBackupPlan.BackupSizeBytes),
+		DeviceID:          utils.Coalesce(backupPlan.DeviceID, existingBackupPlan.DeviceID),
+		RetentionPolicyID: utils.Coalesce(backupPlan.RetentionPolicyID, existingBackupPlan.RetentionPolicyID),
+		StorageTargetID:   utils.Coalesce(backupPlan.StorageTargetID, existingBackupPlan.StorageTargetID),
+		Compression:       utils.Coalesce(backupPlan.Compression, existingBackupPlan.Compression),
+		Encryption:        utils.Coalesce(backupPlan.Encryption, existingBackupPlan.Encryption),
+	}
+	if detach.RetentionPolicy {
+		updatedBackupPlan.RetentionPolicyID = nil
+	}
+	if detach.StorageTarget {
+		updatedBackupPlan.StorageTargetID = nil
+	}
+
+	device, err := bps.deviceRepo.GetDeviceByID(ctx, updatedBackupPlan.DeviceID)
+	if err != nil {
+		return err
+	}
+
+	err = bps.checkRetentionPolicy(ctx, updatedBackupPlan.RetentionPolicyID, device.CustomerID)
+	if err != nil {
+		return err
 	}
 
 	// Corrigindo o tratamento dos WeekDays
@@ -98,16 +202,17 @@ func (bps *backupPlanService) UpdateBackupPlan(ctx context.Context, backupPlan *
 		// Se veio WeekDays no request, usar os dados fornecidos com coalesce
 		updatedBackupPlan.WeekDays = make([]domain.BackupPlanWeekDay, len(backupPlan.WeekDays))
 		for i, wd := range backupPlan.WeekDays {
-			// Verificar se o índice existe no existingBackupPlan
-			var existingDay *domain.BackupPlanWeekDay
+			// Dias além dos existentes não têm o que herdar
+			existingDay := domain.BackupPlanWeekDay{BackupType: domain.BackupTypeFull}
 			if i < len(existingBackupPlan.WeekDays) {
-				existingDay = &existingBackupPlan.WeekDays[i]
+				existingDay = existingBackupPlan.WeekDays[i]
 			}
 
 			updatedBackupPlan.WeekDays[i] = domain.BackupPlanWeekDay{
-				Day:       utils.Coalesce(wd.Day, existingDay.Day),
-				TimeDay:   utils.Coalesce(wd.TimeDay, existingDay.TimeDay),
-				CreatedAt: utils.Coalesce(wd.CreatedAt, existingDay.CreatedAt),
+				Day:        utils.Coalesce(wd.Day, existingDay.Day),
+				TimeDay:    utils.Coalesce(wd.TimeDay, existingDay.TimeDay),
+				BackupType: utils.Coalesce(wd.BackupType, existingDay.BackupType),
+				CreatedAt:  utils.Coalesce(wd.CreatedAt, existingDay.CreatedAt),
 			}
 		}
 	} else {
@@ -115,7 +220,24 @@ func (bps *backupPlanService) UpdateBackupPlan(ctx context.Context, backupPlan *
 		updatedBackupPlan.WeekDays = existingBackupPlan.WeekDays
 	}
 
-	err = bps.backupPlanRepo.UpdateBackupPlan(ctx, updatedBackupPlan)
+	// As origens informadas substituem todas as anteriores
+	updatedBackupPlan.Sources = existingBackupPlan.Sources
+	if len(backupPlan.Sources) > 0 {
+		updatedBackupPlan.Sources = backupPlan.Sources
+	}
+
+	if err := updatedBackupPlan.Validate(); err != nil {
+		return err
+	}
+
+	// A projeção de uso só muda com o destino, o cliente, o tamanho, a
+	// frequência ou a retenção; fora isso o plano já foi aceito antes.
+	var check port.StorageCapacityCheck
+	if storageProjectionChanged(existingBackupPlan, updatedBackupPlan) {
+		check = bps.storageCapacityCheck(updatedBackupPlan, device.CustomerID, force)
+	}
+
+	err = bps.backupPlanRepo.UpdateBackupPlan(ctx, updatedBackupPlan, check)
 	if err != nil {
 		return err
 	}
@@ -124,6 +246,9 @@ func (bps *backupPlanService) UpdateBackupPlan(ctx context.Context, backupPlan *
 }
 
 func (bps *backupPlanService) DeleteBackupPlan(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "backupPlanService.DeleteBackupPlan")
+	defer span.End()
+
 	backupPlan, err := bps.backupPlanRepo.GetBackupPlanByID(ctx, id)
 	if err != nil {
 		return err
@@ -136,3 +261,88 @@ func (bps *backupPlanService) DeleteBackupPlan(ctx context.Context, id uuid.UUID
 
 	return nil
 }
+
+func storageProjectionChanged(existing, updated *domain.BackupPlan) bool {
+	return !equalUUIDPtr(existing.StorageTargetID, updated.StorageTargetID) ||
+		existing.DeviceID != updated.DeviceID ||
+		existing.BackupSizeBytes.Cmp(updated.BackupSizeBytes) != 0 ||
+		len(existing.WeekDays) != len(updated.WeekDays) ||
+		!equalUUIDPtr(existing.RetentionPolicyID, updated.RetentionPolicyID)
+}
+
+func equalUUIDPtr(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// checkRetentionPolicy garante que a política exista e seja do mesmo cliente
+// do dispositivo do plano.
+func (bps *backupPlanService) checkRetentionPolicy(ctx context.Context, id *uuid.UUID, customerID uuid.UUID) error {
+	if id == nil {
+		return nil
+	}
+
+	policy, err := bps.retentionRepo.GetRetentionPolicyByID(ctx, *id)
+	if err != nil {
+		return err
+	}
+
+	if policy.CustomerID != customerID {
+		return domain.ErrRetentionPolicyCustomer
+	}
+
+	return nil
+}
+
+// storageCapacityCheck confere, dentro da transação que grava o plano, se o
+// destino é do cliente e comporta o plano com os demais já gravados nele.
+// Com force, o excesso só é registrado no log.
+func (bps *backupPlanService) storageCapacityCheck(backupPlan *domain.BackupPlan, customerID uuid.UUID, force bool) port.StorageCapacityCheck {
+	return func(ctx context.Context, target *domain.StorageTarget, plans []domain.StorageTargetPlan) error {
+		if !target.AvailableTo(customerID) {
+			return domain.ErrStorageTargetCustomer
+		}
+
+		usage, err := projectStorageUsage(ctx, bps.retentionRepo, target, withStorageTargetPlan(plans, backupPlan))
+		if err != nil {
+			return err
+		}
+
+		if !usage.Exceeds() {
+			return nil
+		}
+
+		if !force {
+			return domain.ErrCapacityExceeded.WithDetails(map[string]string{
+				"capacity_bytes":  usage.CapacityBytes.String(),
+				"projected_bytes": usage.ProjectedBytes.String(),
+			})
+		}
+
+		utils.Logger(ctx).WarnContext(ctx, "Plano de backup excede a capacidade do destino",
+			"backup_plan_id", backupPlan.ID.String(),
+			"storage_target_id", target.ID.String(),
+			"capacity_bytes", usage.CapacityBytes.String(),
+			"projected_bytes", usage.ProjectedBytes.String(),
+		)
+		return nil
+	}
+}
+
+// withStorageTargetPlan troca a versão gravada do plano, se houver, pelos
+// valores novos, para que a alteração não conte o plano duas vezes.
+func withStorageTargetPlan(plans []domain.StorageTargetPlan, backupPlan *domain.BackupPlan) []domain.StorageTargetPlan {
+	plans = slices.DeleteFunc(slices.Clone(plans), func(plan domain.StorageTargetPlan) bool {
+		return plan.BackupPlanID == backupPlan.ID
+	})
+
+	return append(plans, domain.StorageTargetPlan{
+		BackupPlanID:      backupPlan.ID,
+		Name:              backupPlan.Name,
+		BackupSizeBytes:   backupPlan.BackupSizeBytes,
+		RunsPerWeek:       len(backupPlan.WeekDays),
+		RetentionPolicyID: backupPlan.RetentionPolicyID,
+	})
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/google/uuid"
+)
+
+// retentionRepoStub atende só GetRetentionPolicyByID e conta as consultas.
+type retentionRepoStub struct {
+	port.RetentionPolicyRepository
+	policies map[uuid.UUID]*domain.RetentionPolicy
+	calls    int
+}
+
+func (r *retentionRepoStub) GetRetentionPolicyByID(_ context.Context, id uuid.UUID) (*domain.RetentionPolicy, error) {
+	r.calls++
+	policy, ok := r.policies[id]
+	if !ok {
+		return nil, domain.ErrDataNotFound
+	}
+	return policy, nil
+}
+
+func TestStorageCapacityCheck(t *testing.T) {
+	customerID := uuid.New()
+	planID := uuid.New()
+	policyID := uuid.New()
+	policies := map[uuid.UUID]*domain.RetentionPolicy{
+		policyID: {ID: policyID, KeepLast: 3},
+	}
+
+	stored := func(id uuid.UUID, size int64, retentionPolicyID *uuid.UUID) domain.StorageTargetPlan {
+		return domain.StorageTargetPlan{
+			BackupPlanID:      id,
+			BackupSizeBytes:   big.NewInt(size),
+			RunsPerWeek:       1,
+			RetentionPolicyID: retentionPolicyID,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		targetOf   *uuid.UUID
+		plans      []domain.StorageTargetPlan
+		size       int64
+		policyID   *uuid.UUID
+		force      bool
+		want       error
+		projected  string
+		policyGets int
+	}{
+		{
+			name:  "cabe no destino",
+			plans: []domain.StorageTargetPlan{stored(uuid.New(), 500, nil)},
+			size:  400,
+		},
+		{
+			name:      "ultrapassa a capacidade",
+			plans:     []domain.StorageTargetPlan{stored(uuid.New(), 500, nil)},
+			size:      600,
+			want:      domain.ErrCapacityExceeded,
+			projected: "1100",
+		},
+		{
+			name:  "ultrapassa com force",
+			plans: []domain.StorageTargetPlan{stored(uuid.New(), 500, nil)},
+			size:  600,
+			force: true,
+		},
+		{
+			name: "alteração substitui a versão gravada do plano",
+			plans: []domain.StorageTargetPlan{
+				stored(planID, 900, nil),
+				stored(uuid.New(), 50, nil),
+			},
+			size: 300,
+		},
+		{
+			name:       "cópias da política de retenção",
+			plans:      []domain.StorageTargetPlan{stored(uuid.New(), 200, nil)},
+			size:       300,
+			policyID:   &policyID,
+			want:       domain.ErrCapacityExceeded,
+			projected:  "1100",
+			policyGets: 1,
+		},
+		{
+			name:       "política compartilhada consultada uma vez",
+			plans:      []domain.StorageTargetPlan{stored(uuid.New(), 100, &policyID)},
+			size:       100,
+			policyID:   &policyID,
+			policyGets: 1,
+		},
+		{
+			name:     "destino de outro cliente",
+			targetOf: func() *uuid.UUID { id := uuid.New(); return &id }(),
+			size:     100,
+			want:     domain.ErrStorageTargetCustomer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retentionRepo := &retentionRepoStub{policies: policies}
+			bps := &backupPlanService{retentionRepo: retentionRepo}
+
+			target := &domain.StorageTarget{
+				ID:            uuid.New(),
+				CustomerID:    tt.targetOf,
+				CapacityBytes: big.NewInt(1000),
+			}
+			backupPlan := &domain.BackupPlan{
+				ID:                planID,
+				BackupSizeBytes:   big.NewInt(tt.size),
+				RetentionPolicyID: tt.policyID,
+				WeekDays:          []domain.BackupPlanWeekDay{{Day: "Monday", BackupType: domain.BackupTypeFull}},
+			}
+			before := len(tt.plans)
+
+			err := bps.storageCapacityCheck(backupPlan, customerID, tt.force)(context.Background(), target, tt.plans)
+			if tt.want == nil && err != nil {
+				t.Fatalf("check = %v, want nil", err)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Fatalf("check = %v, want %v", err, tt.want)
+			}
+
+			if tt.projected != "" {
+				var domainErr *domain.Error
+				errors.As(err, &domainErr)
+				details, _ := domainErr.Details.(map[string]string)
+				if details["projected_bytes"] != tt.projected {
+					t.Errorf("detalhes = %v, want projected_bytes %s", details, tt.projected)
+				}
+			}
+
+			if retentionRepo.calls != tt.policyGets {
+				t.Errorf("consultas de política = %d, want %d", retentionRepo.calls, tt.policyGets)
+			}
+			if len(tt.plans) != before {
+				t.Errorf("planos gravados alterados: len = %d, want %d", len(tt.plans), before)
+			}
+		})
+	}
+}
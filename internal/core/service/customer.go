@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
@@ -22,6 +23,9 @@ func NewCustomerService(repo port.CustomerRepository, deviceRepo port.DeviceRepo
 }
 
 func (cs *customerService) CreateCustomer(ctx context.Context, customer *domain.Customer) error {
+	ctx, span := tracer.Start(ctx, "customerService.CreateCustomer")
+	defer span.End()
+
 	existingCustomer, _ := cs.repo.GetCustomerByName(ctx, customer.Name)
 	if existingCustomer != nil {
 		return domain.ErrConflictingData
@@ -36,11 +40,14 @@ func (cs *customerService) CreateCustomer(ctx context.Context, customer *domain.
 }
 
 func (cs *customerService) GetCustomer(ctx context.Context, id uuid.UUID) (*domain.Customer, error) {
+	ctx, span := tracer.Start(ctx, "customerService.GetCustomer")
+	defer span.End()
+
 	var customer *domain.Customer
 
 	customer, err := cs.repo.GetCustomerByID(ctx, id)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
 		return nil, domain.ErrInternal
@@ -50,6 +57,9 @@ func (cs *customerService) GetCustomer(ctx context.Context, id uuid.UUID) (*doma
 }
 
 func (cs *customerService) ListCustomers(ctx context.Context, page, limit int) ([]domain.Customer, error) {
+	ctx, span := tracer.Start(ctx, "customerService.ListCustomers")
+	defer span.End()
+
 	var customers []domain.Customer
 
 	customers, err := cs.repo.ListCustomers(ctx, page, limit)
@@ -61,6 +71,9 @@ func (cs *customerService) ListCustomers(ctx context.Context, page, limit int) (
 }
 
 func (cs *customerService) UpdateCustomer(ctx context.Context, customer *domain.Customer) error {
+	ctx, span := tracer.Start(ctx, "customerService.UpdateCustomer")
+	defer span.End()
+
 	existingCustomer, err := cs.repo.GetCustomerByID(ctx, customer.ID)
 	if err != nil {
 		return err
@@ -91,9 +104,12 @@ func (cs *customerService) UpdateCustomer(ctx context.Context, customer *domain.
 }
 
 func (cs *customerService) DeleteCustomer(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "customerService.DeleteCustomer")
+	defer span.End()
+
 	existingCustomer, err := cs.repo.GetCustomerByID(ctx, id)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return err
 		}
 		return domain.ErrInternal
@@ -101,7 +117,7 @@ func (cs *customerService) DeleteCustomer(ctx context.Context, id uuid.UUID) err
 
 	existingCustomerSameDevices, err := cs.deviceRepo.GetDeviceByCustomerID(ctx, id)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return err
 		}
 		return domain.ErrInternal
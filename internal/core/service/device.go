@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
@@ -21,6 +22,9 @@ func NewDeviceService(deviceRepo port.DeviceRepository, customerRepo port.Custom
 }
 
 func (ds *deviceService) CreateDevice(ctx context.Context, device *domain.Device) error {
+	ctx, span := tracer.Start(ctx, "deviceService.CreateDevice")
+	defer span.End()
+
 	customer, err := ds.customerRepo.GetCustomerByID(ctx, device.CustomerID)
 	if err != nil {
 		return err
@@ -36,9 +40,12 @@ func (ds *deviceService) CreateDevice(ctx context.Context, device *domain.Device
 }
 
 func (ds *deviceService) GetDevice(ctx context.Context, id uuid.UUID) (*domain.Device, error) {
+	ctx, span := tracer.Start(ctx, "deviceService.GetDevice")
+	defer span.End()
+
 	device, err := ds.deviceRepo.GetDeviceByID(ctx, id)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
 		return nil, domain.ErrInternal
@@ -48,6 +55,9 @@ func (ds *deviceService) GetDevice(ctx context.Context, id uuid.UUID) (*domain.D
 }
 
 func (ds *deviceService) ListDevices(ctx context.Context, page, limit int) ([]domain.Device, error) {
+	ctx, span := tracer.Start(ctx, "deviceService.ListDevices")
+	defer span.End()
+
 	var devices []domain.Device
 
 	devices, err := ds.deviceRepo.ListDevices(ctx, page, limit)
@@ -59,6 +69,9 @@ func (ds *deviceService) ListDevices(ctx context.Context, page, limit int) ([]do
 }
 
 func (ds *deviceService) UpdateDevice(ctx context.Context, device *domain.Device) error {
+	ctx, span := tracer.Start(ctx, "deviceService.UpdateDevice")
+	defer span.End()
+
 	existingDevice, err := ds.deviceRepo.GetDeviceByID(ctx, device.ID)
 	if err != nil {
 		return err
@@ -83,9 +96,12 @@ func (ds *deviceService) UpdateDevice(ctx context.Context, device *domain.Device
 }
 
 func (ds *deviceService) DeleteDevice(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "deviceService.DeleteDevice")
+	defer span.End()
+
 	existingDevice, err := ds.deviceRepo.GetDeviceByID(ctx, id)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return err
 		}
 		return domain.ErrInternal
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/google/uuid"
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type mfaService struct {
+	userRepo      port.UserRepository
+	mfaRepo       port.MFARepository
+	totp          port.TOTPService
+	recoveryCodes int
+}
+
+func NewMFAService(userRepo port.UserRepository, mfaRepo port.MFARepository, totp port.TOTPService, recoveryCodes int) port.MFAService {
+	return &mfaService{
+		userRepo,
+		mfaRepo,
+		totp,
+		recoveryCodes,
+	}
+}
+
+// EnrollTOTP gera um novo segredo, que só passa a ser exigido no login depois
+// de confirmado com um código válido em ConfirmTOTP.
+func (ms *mfaService) EnrollTOTP(ctx context.Context, userID uuid.UUID) (*domain.TOTPEnrollment, error) {
+	ctx, span := tracer.Start(ctx, "mfaService.EnrollTOTP")
+	defer span.End()
+
+	user, err := ms.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.MFAEnabled {
+		return nil, domain.ErrMFAAlreadyEnabled
+	}
+
+	secret, err := ms.totp.GenerateSecret()
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	codes := make([]string, ms.recoveryCodes)
+	hashes := make([]string, ms.recoveryCodes)
+	for i := range codes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, domain.ErrInternal.Wrap(err)
+		}
+		codes[i] = code
+		hashes[i] = hashRecoveryCode(code)
+	}
+
+	err = ms.mfaRepo.SetTOTPSecret(ctx, user.ID, secret, hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TOTPEnrollment{
+		Secret:        secret,
+		URI:           ms.totp.URI(secret, user.Username),
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (ms *mfaService) ConfirmTOTP(ctx context.Context, userID uuid.UUID, code string) error {
+	ctx, span := tracer.Start(ctx, "mfaService.ConfirmTOTP")
+	defer span.End()
+
+	user, err := ms.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.MFAEnabled {
+		return domain.ErrMFAAlreadyEnabled
+	}
+
+	if user.TOTPSecret == "" {
+		return domain.ErrMFANotEnrolled
+	}
+
+	step, ok := ms.totp.Validate(user.TOTPSecret, code, time.Now(), user.TOTPLastStep)
+	if !ok {
+		return domain.ErrInvalidMFACode
+	}
+
+	return ms.mfaRepo.EnableTOTP(ctx, user.ID, step)
+}
+
+func (ms *mfaService) DisableTOTP(ctx context.Context, userID uuid.UUID, code string) error {
+	ctx, span := tracer.Start(ctx, "mfaService.DisableTOTP")
+	defer span.End()
+
+	user, err := ms.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !user.MFAEnabled {
+		return domain.ErrMFANotEnrolled
+	}
+
+	err = verifySecondFactor(ctx, ms.mfaRepo, ms.totp, user, code)
+	if err != nil {
+		return err
+	}
+
+	return ms.mfaRepo.DisableTOTP(ctx, user.ID)
+}
+
+// verifySecondFactor aceita um código TOTP ainda não utilizado ou um código
+// de recuperação, que é consumido.
+func verifySecondFactor(ctx context.Context, mfaRepo port.MFARepository, totp port.TOTPService, user *domain.User, code string) error {
+	code = strings.TrimSpace(code)
+
+	if step, ok := totp.Validate(user.TOTPSecret, code, time.Now(), user.TOTPLastStep); ok {
+		err := mfaRepo.UpdateTOTPStep(ctx, user.ID, step)
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return domain.ErrInvalidMFACode
+		}
+		return err
+	}
+
+	err := mfaRepo.UseRecoveryCode(ctx, user.ID, hashRecoveryCode(code))
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return domain.ErrInvalidMFACode
+	}
+	return err
+}
+
+// generateRecoveryCode devolve 50 bits aleatórios no formato xxxxx-xxxxx.
+func generateRecoveryCode() (string, error) {
+	raw := make([]byte, 10)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+
+	code := strings.ToLower(recoveryCodeEncoding.EncodeToString(raw))[:10]
+	return code[:5] + "-" + code[5:], nil
+}
+
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
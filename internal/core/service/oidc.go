@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+)
+
+const (
+	oidcUsernameMinLength = 3
+	oidcUsernameMaxLength = 50
+	oidcUsernameAttempts  = 5
+)
+
+type oidcService struct {
+	provider  port.OIDCProvider
+	stateRepo port.OIDCStateRepository
+	userRepo  port.UserRepository
+	passwords port.PasswordService
+	sessions  port.SessionService
+	policy    domain.OIDCPolicy
+}
+
+func NewOIDCService(provider port.OIDCProvider, stateRepo port.OIDCStateRepository, userRepo port.UserRepository, passwords port.PasswordService, sessions port.SessionService, policy domain.OIDCPolicy) port.OIDCService {
+	return &oidcService{
+		provider,
+		stateRepo,
+		userRepo,
+		passwords,
+		sessions,
+		policy,
+	}
+}
+
+// Login gera state, nonce e o verificador PKCE e devolve a URL de
+// autorização do provedor para onde o usuário deve ser redirecionado, junto
+// do state, que o handler prende ao navegador.
+func (ois *oidcService) Login(ctx context.Context) (*domain.OIDCLogin, error) {
+	ctx, span := tracer.Start(ctx, "oidcService.Login")
+	defer span.End()
+
+	return ois.begin(ctx, nil)
+}
+
+// Link inicia o mesmo fluxo para um usuário já autenticado, que no callback
+// tem a conta vinculada ao subject do provedor. É o único caminho de vínculo
+// para contas administrativas.
+func (ois *oidcService) Link(ctx context.Context, userID uuid.UUID) (*domain.OIDCLogin, error) {
+	ctx, span := tracer.Start(ctx, "oidcService.Link")
+	defer span.End()
+
+	return ois.begin(ctx, &userID)
+}
+
+func (ois *oidcService) begin(ctx context.Context, userID *uuid.UUID) (*domain.OIDCLogin, error) {
+	state, err := randomToken()
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+	verifier, err := randomToken()
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	// A URL é montada antes de gravar o state, para não deixar states
+	// órfãos quando o provedor está indisponível.
+	challenge := sha256.Sum256([]byte(verifier))
+	authURL, err := ois.provider.AuthCodeURL(ctx, state, nonce, base64.RawURLEncoding.EncodeToString(challenge[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(ois.policy.StateTTL)
+	err = ois.stateRepo.CreateOIDCState(ctx, &domain.OIDCState{
+		StateHash:    hashUserToken(state),
+		CodeVerifier: verifier,
+		Nonce:        nonce,
+		UserID:       userID,
+		ExpiresAt:    expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.OIDCLogin{
+		AuthURL:   authURL,
+		State:     state,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Callback troca o código pelo ID token, vincula ou cria o usuário local e
+// emite o token normal da API. O segundo fator fica a cargo do provedor.
+func (ois *oidcService) Callback(ctx context.Context, state, code string) (*domain.LoginResult, error) {
+	ctx, span := tracer.Start(ctx, "oidcService.Callback")
+	defer span.End()
+
+	loginState, err := ois.stateRepo.ConsumeOIDCState(ctx, hashUserToken(state))
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return nil, domain.ErrInvalidOIDCState
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := ois.provider.Exchange(ctx, code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		utils.Logger(ctx).WarnContext(ctx, "Falha ao validar login OIDC", "error", err.Error())
+		return nil, err
+	}
+
+	var user *domain.User
+	if loginState.UserID != nil {
+		user, err = ois.linkUser(ctx, *loginState.UserID, identity)
+	} else {
+		user, err = ois.resolveUser(ctx, identity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if user.IsLocked(now) {
+		return nil, domain.ErrAccountLocked.WithRetryAfter(user.LockedUntil.Sub(now))
+	}
+
+	if len(ois.policy.RoleMapping) > 0 {
+		role := ois.policy.RoleFor(identity.Groups)
+		if role != user.Role {
+			if err := ois.userRepo.SetUserRole(ctx, user.ID, role); err != nil {
+				return nil, err
+			}
+			utils.Logger(ctx).InfoContext(ctx, "Papel do usuário sincronizado com o provedor", "user_id", user.ID.String(), "role", string(role))
+			user.Role = role
+		}
+	}
+
+	if user.EmailVerifiedAt == nil && identity.EmailVerified && strings.EqualFold(user.Email, identity.Email) {
+		if err := ois.userRepo.MarkEmailVerified(ctx, user.ID); err != nil {
+			return nil, err
+		}
+		user.EmailVerifiedAt = &now
+	}
+
+	// Mesmas regras do login por senha: sem e-mail confirmado não entra e,
+	// com troca de senha pendente, o token emitido só permite a troca.
+	if ois.policy.RequireEmailVerification && user.EmailVerifiedAt == nil {
+		return nil, domain.ErrEmailNotVerified
+	}
+
+	if user.FailedLoginAttempts > 0 || user.LockedUntil != nil {
+		if err := ois.userRepo.ResetFailedLogins(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	accessToken, err := ois.sessions.Issue(ctx, user, identity.MFA)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.LoginResult{
+		AccessToken: accessToken,
+	}, nil
+}
+
+// resolveUser procura pelo subject, depois por e-mail confirmado pelo
+// provedor e, se permitido, cria a conta.
+func (ois *oidcService) resolveUser(ctx context.Context, identity *domain.OIDCIdentity) (*domain.User, error) {
+	user, err := ois.userRepo.GetUserByOIDCSubject(ctx, identity.Subject)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, domain.ErrDataNotFound) {
+		return nil, err
+	}
+
+	if identity.Email != "" && identity.EmailVerified {
+		user, err = ois.userRepo.GetUserByEmail(ctx, identity.Email)
+		if err == nil {
+			// Quem controla o e-mail no provedor não deve herdar uma conta
+			// administrativa; o vínculo dela passa por Link.
+			if user.Role == domain.Admin {
+				utils.Logger(ctx).WarnContext(ctx, "Vínculo OIDC automático recusado para conta administrativa", "user_id", user.ID.String())
+				return nil, domain.ErrSSOLinkRequired
+			}
+
+			if err := ois.userRepo.LinkOIDCSubject(ctx, user.ID, identity.Subject); err != nil {
+				return nil, err
+			}
+			return user, nil
+		}
+		if !errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+	}
+
+	if !ois.policy.AutoProvision || identity.Email == "" {
+		return nil, domain.ErrSSONotProvisioned
+	}
+
+	return ois.provision(ctx, identity)
+}
+
+// linkUser vincula o subject à conta de quem iniciou o fluxo por Link. O
+// subject já vinculado a outra conta é recusado pelo repositório.
+func (ois *oidcService) linkUser(ctx context.Context, userID uuid.UUID, identity *domain.OIDCIdentity) (*domain.User, error) {
+	user, err := ois.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ois.userRepo.LinkOIDCSubject(ctx, user.ID, identity.Subject); err != nil {
+		return nil, err
+	}
+
+	utils.Logger(ctx).InfoContext(ctx, "Conta vinculada ao provedor OIDC", "user_id", user.ID.String())
+	return user, nil
+}
+
+func (ois *oidcService) provision(ctx context.Context, identity *domain.OIDCIdentity) (*domain.User, error) {
+	username, err := ois.availableUsername(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	// A conta não tem senha local utilizável: o hash é de um valor aleatório
+	// que nunca é exibido.
+	secret, err := randomToken()
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+	hash, err := ois.passwords.Hash(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	fullname := identity.Name
+	if fullname == "" {
+		fullname = username
+	}
+
+	user := &domain.User{
+		ID:       uuid.New(),
+		Fullname: fullname,
+		Email:    identity.Email,
+		Username: username,
+		Password: hash,
+		Role:     ois.policy.RoleFor(identity.Groups),
+	}
+	if identity.EmailVerified {
+		now := time.Now()
+		user.EmailVerifiedAt = &now
+	}
+
+	if err := ois.userRepo.CreateUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if err := ois.userRepo.LinkOIDCSubject(ctx, user.ID, identity.Subject); err != nil {
+		return nil, err
+	}
+
+	utils.Logger(ctx).InfoContext(ctx, "Usuário criado via login OIDC", "user_id", user.ID.String(), "role", string(user.Role))
+	return user, nil
+}
+
+// availableUsername deriva um username alfanumérico das claims e acrescenta
+// um sufixo numérico quando ele já está em uso.
+func (ois *oidcService) availableUsername(ctx context.Context, identity *domain.OIDCIdentity) (string, error) {
+	base := sanitizeUsername(identity.PreferredUsername)
+	if base == "" {
+		local, _, _ := strings.Cut(identity.Email, "@")
+		base = sanitizeUsername(local)
+	}
+	for len(base) < oidcUsernameMinLength {
+		base += "0"
+	}
+
+	candidate := base
+	for range oidcUsernameAttempts {
+		_, err := ois.userRepo.GetUserByUsername(ctx, candidate)
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return candidate, nil
+		}
+		if err != nil {
+			return "", err
+		}
+
+		n, err := rand.Int(rand.Reader, big.NewInt(10000))
+		if err != nil {
+			return "", domain.ErrInternal.Wrap(err)
+		}
+		suffix := fmt.Sprintf("%04d", n.Int64())
+		candidate = base[:min(len(base), oidcUsernameMaxLength-len(suffix))] + suffix
+	}
+
+	return "", domain.ErrConflictingData
+}
+
+func sanitizeUsername(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+		}
+		if b.Len() == oidcUsernameMaxLength {
+			break
+		}
+	}
+	return b.String()
+}
+
+func randomToken() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
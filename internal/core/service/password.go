@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+)
+
+type passwordService struct {
+	userRepo  port.UserRepository
+	hasher    port.PasswordHasher
+	breached  port.BreachedPasswordChecker
+	sessions  port.SessionService
+	policy    domain.PasswordPolicy
+	dummyHash string
+}
+
+// NewPasswordService aceita breached nulo quando não há lista de senhas
+// vazadas configurada.
+func NewPasswordService(userRepo port.UserRepository, hasher port.PasswordHasher, breached port.BreachedPasswordChecker, sessions port.SessionService, policy domain.PasswordPolicy) (port.PasswordService, error) {
+	dummyHash, err := hasher.Hash("dummy-password")
+	if err != nil {
+		return nil, err
+	}
+
+	return &passwordService{
+		userRepo,
+		hasher,
+		breached,
+		sessions,
+		policy,
+		dummyHash,
+	}, nil
+}
+
+// Validate aplica a política à nova senha. Para usuários existentes a senha
+// atual e as últimas HistorySize também são recusadas.
+func (ps *passwordService) Validate(ctx context.Context, user *domain.User, password string) error {
+	ctx, span := tracer.Start(ctx, "passwordService.Validate")
+	defer span.End()
+
+	if violations := ps.policy.Violations(password); len(violations) > 0 {
+		lang := i18n.FromContext(ctx)
+
+		messages := make([]string, len(violations))
+		for i, key := range violations {
+			messages[i] = i18n.T(lang, key, ps.params(key)...)
+		}
+
+		return domain.ErrWeakPassword.WithDetails(map[string]string{
+			"password": strings.Join(messages, "; "),
+		})
+	}
+
+	if ps.breached != nil && ps.breached.IsBreached(password) {
+		return domain.ErrPasswordBreached
+	}
+
+	if user == nil || ps.policy.HistorySize == 0 {
+		return nil
+	}
+
+	history, err := ps.userRepo.ListPasswordHistory(ctx, user.ID, ps.policy.HistorySize)
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range append([]string{user.Password}, history...) {
+		ok, _, err := ps.hasher.Verify(hash, password)
+		if err != nil {
+			utils.Logger(ctx).WarnContext(ctx, "Hash de senha inválido no histórico", "error", err.Error(), "user_id", user.ID.String())
+			continue
+		}
+		if ok {
+			return domain.ErrPasswordReused
+		}
+	}
+
+	return nil
+}
+
+func (ps *passwordService) Hash(ctx context.Context, password string) (string, error) {
+	hash, err := ps.hasher.Hash(password)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao criptografar senha do usuário", "error", err)
+		return "", domain.ErrInternal
+	}
+
+	return hash, nil
+}
+
+// Verify compara a senha e, se o hash estiver em formato ou parâmetros
+// antigos, grava um novo hash. Com user nulo o custo é o mesmo e o resultado
+// é sempre falso, para não revelar quais usuários existem.
+func (ps *passwordService) Verify(ctx context.Context, user *domain.User, password string) (bool, error) {
+	ctx, span := tracer.Start(ctx, "passwordService.Verify")
+	defer span.End()
+
+	if user == nil {
+		ps.hasher.Verify(ps.dummyHash, password)
+		return false, nil
+	}
+
+	ok, needsRehash, err := ps.hasher.Verify(user.Password, password)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Hash de senha inválido", "error", err.Error(), "user_id", user.ID.String())
+		return false, nil
+	}
+
+	if !ok || !needsRehash {
+		return ok, nil
+	}
+
+	hash, err := ps.hasher.Hash(password)
+	if err != nil {
+		utils.Logger(ctx).ErrorContext(ctx, "Erro ao refazer hash da senha", "error", err.Error())
+		return true, nil
+	}
+
+	if err := ps.userRepo.RehashPassword(ctx, user.ID, hash); err != nil {
+		return true, nil
+	}
+
+	user.Password = hash
+	return true, nil
+}
+
+func (ps *passwordService) SetPassword(ctx context.Context, user *domain.User, password string) error {
+	ctx, span := tracer.Start(ctx, "passwordService.SetPassword")
+	defer span.End()
+
+	err := ps.Validate(ctx, user, password)
+	if err != nil {
+		return err
+	}
+
+	hash, err := ps.Hash(ctx, password)
+	if err != nil {
+		return err
+	}
+
+	return ps.userRepo.ChangePassword(ctx, user.ID, hash, ps.policy.HistorySize)
+}
+
+// ChangePassword encerra as demais sessões do usuário, mantendo apenas
+// sessionID, de onde a troca foi feita.
+func (ps *passwordService) ChangePassword(ctx context.Context, userID, sessionID uuid.UUID, currentPassword, newPassword string) error {
+	ctx, span := tracer.Start(ctx, "passwordService.ChangePassword")
+	defer span.End()
+
+	user, err := ps.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	ok, err := ps.Verify(ctx, user, currentPassword)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return domain.ErrInvalidCurrentPassword
+	}
+
+	err = ps.SetPassword(ctx, user, newPassword)
+	if err != nil {
+		return err
+	}
+
+	return ps.sessions.RevokeOtherUserSessions(ctx, user.ID, sessionID)
+}
+
+func (ps *passwordService) ForcePasswordChange(ctx context.Context, userID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "passwordService.ForcePasswordChange")
+	defer span.End()
+
+	existingUser, err := ps.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = ps.userRepo.SetMustChangePassword(ctx, existingUser.ID, true)
+	if err != nil {
+		return err
+	}
+
+	return ps.sessions.RevokeUserSessions(ctx, existingUser.ID)
+}
+
+func (ps *passwordService) params(key string) []any {
+	switch key {
+	case "password.min_length":
+		return []any{ps.policy.MinLength}
+	case "password.max_length":
+		return []any{ps.policy.MaxLength}
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/google/uuid"
+)
+
+type restorePointService struct {
+	repo           port.RestorePointRepository
+	deviceRepo     port.DeviceRepository
+	backupPlanRepo port.BackupPlanRepository
+	retentionRepo  port.RetentionPolicyRepository
+	alertRepo      port.SizeAlertRepository
+}
+
+func NewRestorePointService(repo port.RestorePointRepository, deviceRepo port.DeviceRepository, backupPlanRepo port.BackupPlanRepository, retentionRepo port.RetentionPolicyRepository, alertRepo port.SizeAlertRepository) port.RestorePointService {
+	return &restorePointService{
+		repo,
+		deviceRepo,
+		backupPlanRepo,
+		retentionRepo,
+		alertRepo,
+	}
+}
+
+func (rps *restorePointService) ReportRestorePoints(ctx context.Context, deviceID, backupPlanID uuid.UUID, points []domain.RestorePoint) ([]domain.RestorePoint, error) {
+	ctx, span := tracer.Start(ctx, "restorePointService.ReportRestorePoints")
+	defer span.End()
+
+	backupPlan, err := rps.backupPlanRepo.GetBackupPlanByID(ctx, backupPlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	if backupPlan.DeviceID != deviceID {
+		return nil, domain.ErrDataNotFound
+	}
+
+	for i := range points {
+		points[i].BackupPlanID = backupPlan.ID
+		if err := points[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	err = rps.repo.SaveRestorePoints(ctx, points)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rps.detectSizeAnomalies(ctx, backupPlan.ID, points)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if backupPlan.RetentionPolicyID != nil {
+		policy, err := rps.retentionRepo.GetRetentionPolicyByID(ctx, *backupPlan.RetentionPolicyID)
+		if err != nil {
+			return nil, err
+		}
+
+		live, err := rps.repo.ListLiveRestorePoints(ctx, backupPlan.ID, now)
+		if err != nil {
+			return nil, err
+		}
+
+		if changed := policy.Expire(live, now); len(changed) > 0 {
+			err = rps.repo.UpdateRestorePointExpiries(ctx, changed)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return rps.repo.ListExpiredRestorePoints(ctx, backupPlan.ID, now)
+}
+
+func (rps *restorePointService) ListRestorePoints(ctx context.Context, backupPlanID uuid.UUID, page, limit int) ([]domain.RestorePoint, error) {
+	ctx, span := tracer.Start(ctx, "restorePointService.ListRestorePoints")
+	defer span.End()
+
+	_, err := rps.backupPlanRepo.GetBackupPlanByID(ctx, backupPlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rps.repo.ListRestorePoints(ctx, backupPlanID, page, limit)
+}
+
+func (rps *restorePointService) ListDeviceRestorePoints(ctx context.Context, deviceID uuid.UUID, at time.Time) ([]domain.RestorePoint, error) {
+	ctx, span := tracer.Start(ctx, "restorePointService.ListDeviceRestorePoints")
+	defer span.End()
+
+	_, err := rps.deviceRepo.GetDeviceByID(ctx, deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rps.repo.ListDeviceRestorePoints(ctx, deviceID, at)
+}
+
+func (rps *restorePointService) DeleteAgentRestorePoint(ctx context.Context, deviceID, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "restorePointService.DeleteAgentRestorePoint")
+	defer span.End()
+
+	point, err := rps.repo.GetRestorePointByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	backupPlan, err := rps.backupPlanRepo.GetBackupPlanByID(ctx, point.BackupPlanID)
+	if err != nil {
+		return err
+	}
+
+	if backupPlan.DeviceID != deviceID {
+		return domain.ErrDataNotFound
+	}
+
+	return rps.repo.DeleteRestorePoint(ctx, id)
+}
+
+func (rps *restorePointService) GetSizeHistory(ctx context.Context, backupPlanID uuid.UUID) ([]domain.SizeHistoryEntry, error) {
+	ctx, span := tracer.Start(ctx, "restorePointService.GetSizeHistory")
+	defer span.End()
+
+	_, err := rps.backupPlanRepo.GetBackupPlanByID(ctx, backupPlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := rps.repo.ListRestorePointHistory(ctx, backupPlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.SizeHistory(points), nil
+}
+
+func (rps *restorePointService) ListSizeAlerts(ctx context.Context, severity domain.SizeAlertSeverity, page, limit int) ([]domain.SizeAlert, error) {
+	ctx, span := tracer.Start(ctx, "restorePointService.ListSizeAlerts")
+	defer span.End()
+
+	return rps.alertRepo.ListSizeAlerts(ctx, severity, page, limit)
+}
+
+// detectSizeAnomalies compara os pontos recém-informados com a linha de base
+// do plano e grava os alertas encontrados.
+func (rps *restorePointService) detectSizeAnomalies(ctx context.Context, backupPlanID uuid.UUID, reported []domain.RestorePoint) error {
+	history, err := rps.repo.ListRestorePointHistory(ctx, backupPlanID)
+	if err != nil {
+		return err
+	}
+
+	var alerts []domain.SizeAlert
+	for _, entry := range domain.SizeHistory(history) {
+		if entry.Alert == nil {
+			continue
+		}
+
+		isReported := slices.ContainsFunc(reported, func(point domain.RestorePoint) bool { return point.ID == entry.RestorePoint.ID })
+		if !isReported {
+			continue
+		}
+
+		alert := *entry.Alert
+		alert.ID = uuid.New()
+		alerts = append(alerts, alert)
+	}
+
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	return rps.alertRepo.SaveSizeAlerts(ctx, alerts)
+}
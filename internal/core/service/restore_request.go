@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/google/uuid"
+)
+
+type restoreRequestService struct {
+	repo           port.RestoreRequestRepository
+	deviceRepo     port.DeviceRepository
+	backupPlanRepo port.BackupPlanRepository
+	pointRepo      port.RestorePointRepository
+}
+
+func NewRestoreRequestService(repo port.RestoreRequestRepository, deviceRepo port.DeviceRepository, backupPlanRepo port.BackupPlanRepository, pointRepo port.RestorePointRepository) port.RestoreRequestService {
+	return &restoreRequestService{
+		repo,
+		deviceRepo,
+		backupPlanRepo,
+		pointRepo,
+	}
+}
+
+// CreateRestoreRequest exige um ponto não vencido do catálogo do plano e
+// aceita restaurar em outro dispositivo, desde que seja do mesmo cliente do
+// dispositivo do plano.
+func (rrs *restoreRequestService) CreateRestoreRequest(ctx context.Context, request *domain.RestoreRequest) error {
+	ctx, span := tracer.Start(ctx, "restoreRequestService.CreateRestoreRequest")
+	defer span.End()
+
+	backupPlan, err := rrs.backupPlanRepo.GetBackupPlanByID(ctx, request.BackupPlanID)
+	if err != nil {
+		return err
+	}
+
+	point, err := rrs.pointRepo.GetRestorePointByID(ctx, request.RestorePointID)
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return domain.ErrInvalidRestorePoint.WithDetails(map[string]string{"restore_point_id": "restore_point.not_found"})
+	}
+	if err != nil {
+		return err
+	}
+
+	if point.BackupPlanID != backupPlan.ID {
+		return domain.ErrInvalidRestorePoint.WithDetails(map[string]string{"restore_point_id": "restore_point.other_plan"})
+	}
+
+	if point.Expired(time.Now()) {
+		return domain.ErrInvalidRestorePoint.WithDetails(map[string]string{"restore_point_id": "restore_point.expired"})
+	}
+
+	if request.TargetDeviceID == uuid.Nil {
+		request.TargetDeviceID = backupPlan.DeviceID
+	}
+
+	if request.TargetDeviceID != backupPlan.DeviceID {
+		planDevice, err := rrs.deviceRepo.GetDeviceByID(ctx, backupPlan.DeviceID)
+		if err != nil {
+			return err
+		}
+
+		targetDevice, err := rrs.deviceRepo.GetDeviceByID(ctx, request.TargetDeviceID)
+		if err != nil {
+			return err
+		}
+
+		if targetDevice.CustomerID != planDevice.CustomerID {
+			return domain.ErrRestoreTargetDevice
+		}
+	}
+
+	request.Status = domain.RestoreRequested
+	return rrs.repo.CreateRestoreRequest(ctx, request)
+}
+
+func (rrs *restoreRequestService) GetRestoreRequest(ctx context.Context, id uuid.UUID) (*domain.RestoreRequest, error) {
+	ctx, span := tracer.Start(ctx, "restoreRequestService.GetRestoreRequest")
+	defer span.End()
+
+	return rrs.repo.GetRestoreRequestByID(ctx, id)
+}
+
+func (rrs *restoreRequestService) ListRestoreRequests(ctx context.Context, status domain.RestoreRequestStatus, page, limit int) ([]domain.RestoreRequest, error) {
+	ctx, span := tracer.Start(ctx, "restoreRequestService.ListRestoreRequests")
+	defer span.End()
+
+	return rrs.repo.ListRestoreRequests(ctx, status, page, limit)
+}
+
+func (rrs *restoreRequestService) ReviewRestoreRequest(ctx context.Context, reviewerID, id uuid.UUID, approve bool, note string) (*domain.RestoreRequest, error) {
+	ctx, span := tracer.Start(ctx, "restoreRequestService.ReviewRestoreRequest")
+	defer span.End()
+
+	request, err := rrs.repo.GetRestoreRequestByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	from := request.Status
+	if err := request.Review(reviewerID, approve, note, time.Now()); err != nil {
+		return nil, err
+	}
+
+	err = rrs.repo.UpdateRestoreRequest(ctx, request, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (rrs *restoreRequestService) ListAgentRestores(ctx context.Context, deviceID uuid.UUID) ([]domain.RestoreRequest, error) {
+	ctx, span := tracer.Start(ctx, "restoreRequestService.ListAgentRestores")
+	defer span.End()
+
+	return rrs.repo.ListRestoreRequestsByDevice(ctx, deviceID, domain.RestoreApproved)
+}
+
+func (rrs *restoreRequestService) StartRestore(ctx context.Context, deviceID, id uuid.UUID) (*domain.RestoreRequest, error) {
+	ctx, span := tracer.Start(ctx, "restoreRequestService.StartRestore")
+	defer span.End()
+
+	request, err := rrs.deviceRestore(ctx, deviceID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	from := request.Status
+	if err := request.Start(time.Now()); err != nil {
+		return nil, err
+	}
+
+	err = rrs.repo.UpdateRestoreRequest(ctx, request, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (rrs *restoreRequestService) CompleteRestore(ctx context.Context, deviceID, id uuid.UUID, success bool, result string) (*domain.RestoreRequest, error) {
+	ctx, span := tracer.Start(ctx, "restoreRequestService.CompleteRestore")
+	defer span.End()
+
+	request, err := rrs.deviceRestore(ctx, deviceID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	from := request.Status
+	if err := request.Complete(success, result, time.Now()); err != nil {
+		return nil, err
+	}
+
+	err = rrs.repo.UpdateRestoreRequest(ctx, request, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// deviceRestore esconde do agente os pedidos destinados a outros dispositivos.
+func (rrs *restoreRequestService) deviceRestore(ctx context.Context, deviceID, id uuid.UUID) (*domain.RestoreRequest, error) {
+	request, err := rrs.repo.GetRestoreRequestByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.TargetDeviceID != deviceID {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return request, nil
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+)
+
+type retentionPolicyService struct {
+	repo           port.RetentionPolicyRepository
+	customerRepo   port.CustomerRepository
+	backupPlanRepo port.BackupPlanRepository
+}
+
+func NewRetentionPolicyService(repo port.RetentionPolicyRepository, customerRepo port.CustomerRepository, backupPlanRepo port.BackupPlanRepository) port.RetentionPolicyService {
+	return &retentionPolicyService{
+		repo,
+		customerRepo,
+		backupPlanRepo,
+	}
+}
+
+func (rps *retentionPolicyService) CreateRetentionPolicy(ctx context.Context, policy *domain.RetentionPolicy) error {
+	ctx, span := tracer.Start(ctx, "retentionPolicyService.CreateRetentionPolicy")
+	defer span.End()
+
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	_, err := rps.customerRepo.GetCustomerByID(ctx, policy.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	return rps.repo.CreateRetentionPolicy(ctx, policy)
+}
+
+func (rps *retentionPolicyService) GetRetentionPolicy(ctx context.Context, id uuid.UUID) (*domain.RetentionPolicy, error) {
+	ctx, span := tracer.Start(ctx, "retentionPolicyService.GetRetentionPolicy")
+	defer span.End()
+
+	return rps.repo.GetRetentionPolicyByID(ctx, id)
+}
+
+func (rps *retentionPolicyService) ListRetentionPolicies(ctx context.Context, customerID uuid.UUID, page, limit int) ([]domain.RetentionPolicy, error) {
+	ctx, span := tracer.Start(ctx, "retentionPolicyService.ListRetentionPolicies")
+	defer span.End()
+
+	return rps.repo.ListRetentionPolicies(ctx, customerID, page, limit)
+}
+
+// UpdateRetentionPolicy substitui todas as regras; o cliente da política não
+// muda, para não invalidar os planos que já a usam.
+func (rps *retentionPolicyService) UpdateRetentionPolicy(ctx context.Context, policy *domain.RetentionPolicy) error {
+	ctx, span := tracer.Start(ctx, "retentionPolicyService.UpdateRetentionPolicy")
+	defer span.End()
+
+	existingPolicy, err := rps.repo.GetRetentionPolicyByID(ctx, policy.ID)
+	if err != nil {
+		return err
+	}
+
+	policy.CustomerID = existingPolicy.CustomerID
+	policy.Name = utils.Coalesce(policy.Name, existingPolicy.Name)
+
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	return rps.repo.UpdateRetentionPolicy(ctx, policy)
+}
+
+func (rps *retentionPolicyService) DeleteRetentionPolicy(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "retentionPolicyService.DeleteRetentionPolicy")
+	defer span.End()
+
+	count, err := rps.repo.CountBackupPlansByRetentionPolicy(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return domain.ErrConflictingData
+	}
+
+	return rps.repo.DeleteRetentionPolicy(ctx, id)
+}
+
+func (rps *retentionPolicyService) EvaluateRetentionPolicy(ctx context.Context, id uuid.UUID, points []domain.RestorePoint, now time.Time) ([]domain.RetentionDecision, error) {
+	ctx, span := tracer.Start(ctx, "retentionPolicyService.EvaluateRetentionPolicy")
+	defer span.End()
+
+	policy, err := rps.repo.GetRetentionPolicyByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy.Apply(points, now), nil
+}
+
+func (rps *retentionPolicyService) EvaluateBackupPlanRetention(ctx context.Context, backupPlanID uuid.UUID, points []domain.RestorePoint, now time.Time) ([]domain.RetentionDecision, error) {
+	ctx, span := tracer.Start(ctx, "retentionPolicyService.EvaluateBackupPlanRetention")
+	defer span.End()
+
+	backupPlan, err := rps.backupPlanRepo.GetBackupPlanByID(ctx, backupPlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	if backupPlan.RetentionPolicyID == nil {
+		return nil, domain.ErrNoRetentionPolicy
+	}
+
+	policy, err := rps.repo.GetRetentionPolicyByID(ctx, *backupPlan.RetentionPolicyID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range points {
+		points[i].BackupPlanID = backupPlan.ID
+	}
+
+	return policy.Apply(points, now), nil
+}
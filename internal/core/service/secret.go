@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+)
+
+const secretRotationBatch = 100
+
+type secretService struct {
+	repo  port.SecretRepository
+	keyID string
+	keys  map[string][]byte
+}
+
+// NewSecretService recebe todas as chaves mestras conhecidas, indexadas pelo
+// id; keyID é a usada para cifrar. Se ela não estiver em keys, Seal falha e
+// os segredos existentes continuam legíveis.
+func NewSecretService(repo port.SecretRepository, keyID string, keys map[string][]byte) port.SecretService {
+	return &secretService{
+		repo,
+		keyID,
+		keys,
+	}
+}
+
+func (ss *secretService) Seal(ctx context.Context, id uuid.UUID, plaintext []byte) (*domain.Secret, error) {
+	_, span := tracer.Start(ctx, "secretService.Seal")
+	defer span.End()
+
+	masterKey, ok := ss.keys[ss.keyID]
+	if !ok {
+		return nil, domain.ErrSecretsNotConfigured
+	}
+
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	ciphertext, err := sealAESGCM(dataKey, plaintext, id[:])
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	wrappedKey, err := sealAESGCM(masterKey, dataKey, dataKeyAAD(ss.keyID, id))
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return &domain.Secret{
+		ID:         id,
+		KeyID:      ss.keyID,
+		DataKey:    wrappedKey,
+		Ciphertext: ciphertext,
+	}, nil
+}
+
+func (ss *secretService) Open(ctx context.Context, secret *domain.Secret) ([]byte, error) {
+	_, span := tracer.Start(ctx, "secretService.Open")
+	defer span.End()
+
+	masterKey, ok := ss.keys[secret.KeyID]
+	if !ok {
+		return nil, domain.ErrInternal.Wrap(fmt.Errorf("chave mestra %q não configurada", secret.KeyID))
+	}
+
+	dataKey, err := openAESGCM(masterKey, secret.DataKey, dataKeyAAD(secret.KeyID, secret.ID))
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	plaintext, err := openAESGCM(dataKey, secret.Ciphertext, secret.ID[:])
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return plaintext, nil
+}
+
+// RotateMasterKey cifra novamente, com uma nova chave de dados e a chave
+// mestra atual, todos os segredos cifrados por chaves anteriores. Segredos
+// que não podem ser abertos são contados e ficam como estão.
+func (ss *secretService) RotateMasterKey(ctx context.Context) (*domain.SecretRotation, error) {
+	ctx, span := tracer.Start(ctx, "secretService.RotateMasterKey")
+	defer span.End()
+
+	if _, ok := ss.keys[ss.keyID]; !ok {
+		return nil, domain.ErrSecretsNotConfigured
+	}
+
+	rotation := &domain.SecretRotation{KeyID: ss.keyID}
+	after := uuid.Nil
+
+	for {
+		secrets, err := ss.repo.ListSecretsToRotate(ctx, ss.keyID, after, secretRotationBatch)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, secret := range secrets {
+			after = secret.ID
+
+			plaintext, err := ss.Open(ctx, &secret)
+			if err != nil {
+				utils.Logger(ctx).ErrorContext(ctx, "Erro ao abrir segredo na rotação", "secret_id", secret.ID.String(), "key_id", secret.KeyID, "error", err.Error())
+				rotation.Failed++
+				continue
+			}
+
+			resealed, err := ss.Seal(ctx, secret.ID, plaintext)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := ss.repo.UpdateSecret(ctx, resealed); err != nil {
+				return nil, err
+			}
+			rotation.Rotated++
+		}
+
+		if len(secrets) < secretRotationBatch {
+			break
+		}
+	}
+
+	utils.Logger(ctx).InfoContext(ctx, "Rotação da chave mestra concluída", "key_id", rotation.KeyID, "rotated", rotation.Rotated, "failed", rotation.Failed)
+	return rotation, nil
+}
+
+// dataKeyAAD amarra a chave de dados ao segredo e à chave mestra, para que
+// não possa ser copiada para outro registro.
+func dataKeyAAD(keyID string, id uuid.UUID) []byte {
+	return append([]byte(keyID+":"), id[:]...)
+}
+
+// sealAESGCM devolve o nonce seguido do texto cifrado.
+func sealAESGCM(key, plaintext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func openAESGCM(key, sealed, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("segredo truncado")
+	}
+
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, aad)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
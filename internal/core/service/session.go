@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+)
+
+const sessionUserAgentMaxLength = 512
+
+type sessionService struct {
+	repo  port.SessionRepository
+	token port.TokenService
+}
+
+func NewSessionService(repo port.SessionRepository, token port.TokenService) port.SessionService {
+	return &sessionService{
+		repo,
+		token,
+	}
+}
+
+// Issue emite o token de acesso e registra a sessão com o IP e o user agent
+// da requisição. O jti do token é o ID da sessão.
+func (ss *sessionService) Issue(ctx context.Context, user *domain.User, mfa bool) (string, error) {
+	ctx, span := tracer.Start(ctx, "sessionService.Issue")
+	defer span.End()
+
+	issued, err := ss.token.CreateToken(user, mfa)
+	if err != nil {
+		return "", domain.ErrTokenCreation
+	}
+
+	ip, userAgent := utils.Client(ctx)
+	if len(userAgent) > sessionUserAgentMaxLength {
+		userAgent = userAgent[:sessionUserAgentMaxLength]
+	}
+
+	err = ss.repo.CreateSession(ctx, &domain.Session{
+		ID:        issued.ID,
+		UserID:    user.ID,
+		IP:        ip,
+		UserAgent: userAgent,
+		MFA:       mfa,
+		ExpiresAt: issued.ExpiresAt,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return issued.Token, nil
+}
+
+// Validate recusa tokens cuja sessão foi encerrada e registra o último acesso.
+// Chaves de API não têm sessão.
+func (ss *sessionService) Validate(ctx context.Context, payload *domain.TokenPayload) error {
+	ctx, span := tracer.Start(ctx, "sessionService.Validate")
+	defer span.End()
+
+	if payload.IsAPIKey() {
+		return nil
+	}
+
+	session, err := ss.repo.GetSessionByID(ctx, payload.ID)
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return domain.ErrSessionRevoked
+	}
+	if err != nil {
+		return err
+	}
+
+	if session.UserID != payload.UserID || !session.IsActive(time.Now()) {
+		return domain.ErrSessionRevoked
+	}
+
+	if err := ss.repo.TouchSession(ctx, session.ID); err != nil {
+		utils.Logger(ctx).WarnContext(ctx, "Falha ao registrar último acesso da sessão", "error", err.Error())
+	}
+
+	return nil
+}
+
+func (ss *sessionService) ListUserSessions(ctx context.Context, userID uuid.UUID) ([]domain.Session, error) {
+	ctx, span := tracer.Start(ctx, "sessionService.ListUserSessions")
+	defer span.End()
+
+	return ss.repo.ListActiveSessionsByUser(ctx, userID)
+}
+
+func (ss *sessionService) RevokeUserSession(ctx context.Context, userID, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "sessionService.RevokeUserSession")
+	defer span.End()
+
+	session, err := ss.repo.GetSessionByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if session.UserID != userID || !session.IsActive(time.Now()) {
+		return domain.ErrDataNotFound
+	}
+
+	return ss.repo.RevokeSession(ctx, session.ID)
+}
+
+func (ss *sessionService) RevokeUserSessions(ctx context.Context, userID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "sessionService.RevokeUserSessions")
+	defer span.End()
+
+	return ss.repo.RevokeUserSessions(ctx, userID)
+}
+
+func (ss *sessionService) RevokeOtherUserSessions(ctx context.Context, userID, keepID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "sessionService.RevokeOtherUserSessions")
+	defer span.End()
+
+	return ss.repo.RevokeOtherUserSessions(ctx, userID, keepID)
+}
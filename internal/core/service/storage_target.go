@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+)
+
+type storageTargetService struct {
+	repo          port.StorageTargetRepository
+	customerRepo  port.CustomerRepository
+	retentionRepo port.RetentionPolicyRepository
+	secrets       port.SecretService
+}
+
+func NewStorageTargetService(repo port.StorageTargetRepository, customerRepo port.CustomerRepository, retentionRepo port.RetentionPolicyRepository, secrets port.SecretService) port.StorageTargetService {
+	return &storageTargetService{
+		repo,
+		customerRepo,
+		retentionRepo,
+		secrets,
+	}
+}
+
+func (sts *storageTargetService) CreateStorageTarget(ctx context.Context, target *domain.StorageTarget) error {
+	ctx, span := tracer.Start(ctx, "storageTargetService.CreateStorageTarget")
+	defer span.End()
+
+	if err := target.Validate(); err != nil {
+		return err
+	}
+
+	if target.CustomerID != nil {
+		_, err := sts.customerRepo.GetCustomerByID(ctx, *target.CustomerID)
+		if err != nil {
+			return err
+		}
+	}
+
+	credentials, err := sts.sealCredentials(ctx, target, uuid.New())
+	if err != nil {
+		return err
+	}
+
+	return sts.repo.CreateStorageTarget(ctx, target, credentials)
+}
+
+func (sts *storageTargetService) GetStorageTarget(ctx context.Context, id uuid.UUID) (*domain.StorageTarget, error) {
+	ctx, span := tracer.Start(ctx, "storageTargetService.GetStorageTarget")
+	defer span.End()
+
+	return sts.repo.GetStorageTargetByID(ctx, id)
+}
+
+func (sts *storageTargetService) ListStorageTargets(ctx context.Context, customerID uuid.UUID, page, limit int) ([]domain.StorageTarget, error) {
+	ctx, span := tracer.Start(ctx, "storageTargetService.ListStorageTargets")
+	defer span.End()
+
+	return sts.repo.ListStorageTargets(ctx, customerID, page, limit)
+}
+
+// UpdateStorageTarget não muda o dono do destino. Reduzir a capacidade abaixo
+// do uso projetado é permitido; o excesso aparece no relatório de uso.
+func (sts *storageTargetService) UpdateStorageTarget(ctx context.Context, target *domain.StorageTarget) error {
+	ctx, span := tracer.Start(ctx, "storageTargetService.UpdateStorageTarget")
+	defer span.End()
+
+	existingTarget, err := sts.repo.GetStorageTargetByID(ctx, target.ID)
+	if err != nil {
+		return err
+	}
+
+	target.CustomerID = existingTarget.CustomerID
+	target.CredentialsSecretID = existingTarget.CredentialsSecretID
+	target.Name = utils.Coalesce(target.Name, existingTarget.Name)
+	target.Kind = utils.Coalesce(target.Kind, existingTarget.Kind)
+	target.Endpoint = utils.Coalesce(target.Endpoint, existingTarget.Endpoint)
+	target.CapacityBytes = utils.Coalesce(target.CapacityBytes, existingTarget.CapacityBytes)
+
+	if err := target.Validate(); err != nil {
+		return err
+	}
+
+	// Novas credenciais substituem as anteriores no mesmo segredo.
+	secretID := uuid.New()
+	if target.CredentialsSecretID != nil {
+		secretID = *target.CredentialsSecretID
+	}
+
+	credentials, err := sts.sealCredentials(ctx, target, secretID)
+	if err != nil {
+		return err
+	}
+
+	return sts.repo.UpdateStorageTarget(ctx, target, credentials)
+}
+
+func (sts *storageTargetService) DeleteStorageTarget(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "storageTargetService.DeleteStorageTarget")
+	defer span.End()
+
+	plans, err := sts.repo.ListStorageTargetPlans(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if len(plans) > 0 {
+		return domain.ErrConflictingData
+	}
+
+	return sts.repo.DeleteStorageTarget(ctx, id)
+}
+
+func (sts *storageTargetService) GetStorageTargetUsage(ctx context.Context, id uuid.UUID) (*domain.StorageTargetUsage, error) {
+	ctx, span := tracer.Start(ctx, "storageTargetService.GetStorageTargetUsage")
+	defer span.End()
+
+	target, err := sts.repo.GetStorageTargetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	plans, err := sts.repo.ListStorageTargetPlans(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectStorageUsage(ctx, sts.retentionRepo, target, plans)
+}
+
+// sealCredentials cifra as credenciais informadas e as remove do destino, para
+// que o texto puro não siga adiante.
+func (sts *storageTargetService) sealCredentials(ctx context.Context, target *domain.StorageTarget, secretID uuid.UUID) (*domain.Secret, error) {
+	if target.Credentials == nil {
+		return nil, nil
+	}
+
+	plaintext, err := json.Marshal(target.Credentials)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+	target.Credentials = nil
+
+	return sts.secrets.Seal(ctx, secretID, plaintext)
+}
+
+// projectStorageUsage carrega as políticas de retenção dos planos, uma vez
+// cada, e calcula o uso projetado do destino.
+func projectStorageUsage(ctx context.Context, retentionRepo port.RetentionPolicyRepository, target *domain.StorageTarget, plans []domain.StorageTargetPlan) (*domain.StorageTargetUsage, error) {
+	policies := make(map[uuid.UUID]*domain.RetentionPolicy)
+	for _, plan := range plans {
+		if plan.RetentionPolicyID == nil {
+			continue
+		}
+		if _, ok := policies[*plan.RetentionPolicyID]; ok {
+			continue
+		}
+
+		policy, err := retentionRepo.GetRetentionPolicyByID(ctx, *plan.RetentionPolicyID)
+		if err != nil {
+			return nil, err
+		}
+		policies[policy.ID] = policy
+	}
+
+	return target.ProjectUsage(plans, policies), nil
+}
@@ -2,26 +2,36 @@ package service
 
 import (
 	"context"
-	"log/slog"
+	"time"
 
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
 	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
 	"github.com/google/uuid"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type userService struct {
-	repo port.UserRepository
+	repo                     port.UserRepository
+	passwords                port.PasswordService
+	account                  port.AccountService
+	sessions                 port.SessionService
+	requireEmailVerification bool
 }
 
-func NewUserService(repo port.UserRepository) port.UserService {
+func NewUserService(repo port.UserRepository, passwords port.PasswordService, account port.AccountService, sessions port.SessionService, requireEmailVerification bool) port.UserService {
 	return &userService{
 		repo,
+		passwords,
+		account,
+		sessions,
+		requireEmailVerification,
 	}
 }
 
 func (us *userService) Register(ctx context.Context, user *domain.User) error {
+	ctx, span := tracer.Start(ctx, "userService.Register")
+	defer span.End()
+
 	existingUser, _ := us.repo.GetUserByEmail(ctx, user.Email)
 	if existingUser != nil {
 		return domain.ErrConflictingData
@@ -32,22 +42,41 @@ func (us *userService) Register(ctx context.Context, user *domain.User) error {
 		return domain.ErrConflictingData
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+	err := us.passwords.Validate(ctx, nil, user.Password)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := us.passwords.Hash(ctx, user.Password)
 	if err != nil {
-		slog.Error("Erro ao criptografar senha do usuário", "error", err)
-		return domain.ErrInternal
+		return err
+	}
+
+	user.Password = hashedPassword
+	if !us.requireEmailVerification {
+		now := time.Now()
+		user.EmailVerifiedAt = &now
 	}
 
-	user.Password = string(hashedPassword)
 	err = us.repo.CreateUser(ctx, user)
 	if err != nil {
 		return err
 	}
 
+	if us.requireEmailVerification {
+		// O usuário já foi criado; se o envio falhar ele pode pedir um novo link.
+		if err := us.account.SendEmailVerification(ctx, user); err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao enviar verificação de e-mail", "error", err)
+		}
+	}
+
 	return nil
 }
 
 func (us *userService) GetUser(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "userService.GetUser")
+	defer span.End()
+
 	var user *domain.User
 
 	user, err := us.repo.GetUserByID(ctx, id)
@@ -59,6 +88,9 @@ func (us *userService) GetUser(ctx context.Context, id uuid.UUID) (*domain.User,
 }
 
 func (us *userService) ListUsers(ctx context.Context, page, limit int) ([]domain.User, error) {
+	ctx, span := tracer.Start(ctx, "userService.ListUsers")
+	defer span.End()
+
 	var users []domain.User
 
 	users, err := us.repo.ListUsers(ctx, page, limit)
@@ -70,6 +102,9 @@ func (us *userService) ListUsers(ctx context.Context, page, limit int) ([]domain
 }
 
 func (us *userService) UpdateUser(ctx context.Context, user *domain.User) error {
+	ctx, span := tracer.Start(ctx, "userService.UpdateUser")
+	defer span.End()
+
 	existingUser, err := us.repo.GetUserByID(ctx, user.ID)
 	if err != nil {
 		return err
@@ -97,6 +132,10 @@ func (us *userService) UpdateUser(ctx context.Context, user *domain.User) error
 		}
 	}
 
+	// A senha é trocada à parte para passar pela política e pelo histórico;
+	// reenviar a senha atual não conta como troca.
+	newPassword := user.Password
+
 	user = &domain.User{
 		ID:       user.ID,
 		Username: utils.Coalesce(user.Username, existingUser.Username),
@@ -104,26 +143,66 @@ func (us *userService) UpdateUser(ctx context.Context, user *domain.User) error
 		Role:     utils.Coalesce(user.Role, existingUser.Role),
 	}
 
-	if user.Password != "" {
-		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+	changePassword := false
+	if newPassword != "" {
+		same, err := us.passwords.Verify(ctx, existingUser, newPassword)
 		if err != nil {
-			return domain.ErrInternal
+			return err
 		}
 
-		user.Password = string(hashedPassword)
-	} else {
-		user.Password = existingUser.Password
+		if !same {
+			err = us.passwords.Validate(ctx, existingUser, newPassword)
+			if err != nil {
+				return err
+			}
+			changePassword = true
+		}
 	}
 
+	// Verify pode ter refeito o hash da senha atual.
+	user.Password = existingUser.Password
+
 	err = us.repo.UpdateUser(ctx, user)
 	if err != nil {
 		return err
 	}
 
+	if changePassword {
+		err = us.passwords.SetPassword(ctx, existingUser, newPassword)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Com outra senha ou outro papel, os tokens já emitidos não valem mais.
+	if changePassword || user.Role != existingUser.Role {
+		return us.sessions.RevokeUserSessions(ctx, user.ID)
+	}
+
+	return nil
+}
+
+func (us *userService) UnlockUser(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "userService.UnlockUser")
+	defer span.End()
+
+	existingUser, err := us.repo.GetUserByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = us.repo.ResetFailedLogins(ctx, existingUser.ID)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (us *userService) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "userService.DeleteUser")
+	defer span.End()
+
 	existingUser, err := us.repo.GetUserByID(ctx, id)
 	if err != nil {
 		return err
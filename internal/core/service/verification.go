@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/domain"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/port"
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/utils"
+	"github.com/google/uuid"
+)
+
+type verificationService struct {
+	repo           port.VerificationRepository
+	backupPlanRepo port.BackupPlanRepository
+	pointRepo      port.RestorePointRepository
+}
+
+func NewVerificationService(repo port.VerificationRepository, backupPlanRepo port.BackupPlanRepository, pointRepo port.RestorePointRepository) port.VerificationService {
+	return &verificationService{
+		repo,
+		backupPlanRepo,
+		pointRepo,
+	}
+}
+
+func (vs *verificationService) SetVerificationSchedule(ctx context.Context, schedule *domain.VerificationSchedule) error {
+	ctx, span := tracer.Start(ctx, "verificationService.SetVerificationSchedule")
+	defer span.End()
+
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	_, err := vs.backupPlanRepo.GetBackupPlanByID(ctx, schedule.BackupPlanID)
+	if err != nil {
+		return err
+	}
+
+	return vs.repo.SaveVerificationSchedule(ctx, schedule)
+}
+
+func (vs *verificationService) GetVerificationSchedule(ctx context.Context, backupPlanID uuid.UUID) (*domain.VerificationSchedule, error) {
+	ctx, span := tracer.Start(ctx, "verificationService.GetVerificationSchedule")
+	defer span.End()
+
+	return vs.repo.GetVerificationSchedule(ctx, backupPlanID)
+}
+
+func (vs *verificationService) DeleteVerificationSchedule(ctx context.Context, backupPlanID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "verificationService.DeleteVerificationSchedule")
+	defer span.End()
+
+	return vs.repo.DeleteVerificationSchedule(ctx, backupPlanID)
+}
+
+func (vs *verificationService) ListVerificationJobs(ctx context.Context, backupPlanID uuid.UUID, page, limit int) ([]domain.VerificationJob, error) {
+	ctx, span := tracer.Start(ctx, "verificationService.ListVerificationJobs")
+	defer span.End()
+
+	_, err := vs.backupPlanRepo.GetBackupPlanByID(ctx, backupPlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	return vs.repo.ListVerificationJobs(ctx, backupPlanID, page, limit)
+}
+
+func (vs *verificationService) ListAgentVerificationJobs(ctx context.Context, deviceID uuid.UUID) ([]domain.VerificationJob, error) {
+	ctx, span := tracer.Start(ctx, "verificationService.ListAgentVerificationJobs")
+	defer span.End()
+
+	backupPlans, err := vs.backupPlanRepo.ListBackupPlansByDevice(ctx, deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(backupPlans) == 0 {
+		return []domain.VerificationJob{}, nil
+	}
+
+	backupPlanIDs := make([]uuid.UUID, len(backupPlans))
+	for i, backupPlan := range backupPlans {
+		backupPlanIDs[i] = backupPlan.ID
+	}
+
+	return vs.repo.ListPendingVerificationJobs(ctx, backupPlanIDs)
+}
+
+func (vs *verificationService) GenerateVerificationJobs(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "verificationService.GenerateVerificationJobs")
+	defer span.End()
+
+	now := time.Now()
+	statuses, err := verificationStatuses(ctx, vs.repo, nil, now)
+	if err != nil {
+		return err
+	}
+
+	statuses = slices.DeleteFunc(statuses, func(status domain.VerificationStatus) bool {
+		return now.Before(status.DueAt)
+	})
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	dueIDs := make([]uuid.UUID, len(statuses))
+	for i, status := range statuses {
+		dueIDs[i] = status.BackupPlanID
+	}
+
+	pending, err := vs.repo.ListPendingVerificationJobs(ctx, dueIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, status := range statuses {
+		if slices.ContainsFunc(pending, func(job domain.VerificationJob) bool { return job.BackupPlanID == status.BackupPlanID }) {
+			continue
+		}
+
+		// Uma falha em um plano, como um plano excluído depois da consulta,
+		// não impede a geração para os demais.
+		backupPlan, err := vs.backupPlanRepo.GetBackupPlanByID(ctx, status.BackupPlanID)
+		if err != nil {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao gerar verificação do plano", "backup_plan_id", status.BackupPlanID.String(), "error", err.Error())
+			continue
+		}
+
+		scheduledAt, ok := backupPlan.NextRun(now)
+		if !ok {
+			continue
+		}
+
+		job := &domain.VerificationJob{
+			ID:           uuid.New(),
+			BackupPlanID: status.BackupPlanID,
+			Kind:         status.Schedule.Kind,
+			Status:       domain.VerificationPending,
+			ScheduledAt:  scheduledAt,
+		}
+
+		// ErrConflictingData: outra instância criou a verificação pendente ao
+		// mesmo tempo.
+		err = vs.repo.CreateVerificationJob(ctx, job)
+		if err != nil && !errors.Is(err, domain.ErrConflictingData) {
+			utils.Logger(ctx).ErrorContext(ctx, "Erro ao gerar verificação do plano", "backup_plan_id", status.BackupPlanID.String(), "error", err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (vs *verificationService) CompleteVerificationJob(ctx context.Context, deviceID, id, restorePointID uuid.UUID, success bool, result string) (*domain.VerificationJob, error) {
+	ctx, span := tracer.Start(ctx, "verificationService.CompleteVerificationJob")
+	defer span.End()
+
+	job, err := vs.repo.GetVerificationJobByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	backupPlan, err := vs.backupPlanRepo.GetBackupPlanByID(ctx, job.BackupPlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	if backupPlan.DeviceID != deviceID {
+		return nil, domain.ErrDataNotFound
+	}
+
+	now := time.Now()
+	point, err := vs.pointRepo.GetRestorePointByID(ctx, restorePointID)
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return nil, domain.ErrInvalidRestorePoint.WithDetails(map[string]string{"restore_point_id": "restore_point.not_found"})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if point.BackupPlanID != job.BackupPlanID {
+		return nil, domain.ErrInvalidRestorePoint.WithDetails(map[string]string{"restore_point_id": "restore_point.other_plan"})
+	}
+
+	if point.Expired(now) {
+		return nil, domain.ErrInvalidRestorePoint.WithDetails(map[string]string{"restore_point_id": "restore_point.expired"})
+	}
+
+	// O ponto verificado precisa ser do backup agendado ou posterior.
+	if point.CreatedAt.Before(job.ScheduledAt) {
+		return nil, domain.ErrInvalidRestorePoint.WithDetails(map[string]string{"restore_point_id": "verification.point_before_schedule"})
+	}
+
+	if err := job.Complete(point.ID, success, result, now); err != nil {
+		return nil, err
+	}
+
+	err = vs.repo.UpdateVerificationJob(ctx, job, domain.VerificationPending)
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (vs *verificationService) VerificationReport(ctx context.Context, onlyOverdue bool, now time.Time) ([]domain.VerificationStatus, error) {
+	ctx, span := tracer.Start(ctx, "verificationService.VerificationReport")
+	defer span.End()
+
+	statuses, err := verificationStatuses(ctx, vs.repo, nil, now)
+	if err != nil {
+		return nil, err
+	}
+
+	report := []domain.VerificationStatus{}
+	for _, status := range statuses {
+		if onlyOverdue && !status.Overdue {
+			continue
+		}
+		report = append(report, status)
+	}
+
+	slices.SortStableFunc(report, func(a, b domain.VerificationStatus) int {
+		switch {
+		case a.Overdue == b.Overdue:
+			return a.DueAt.Compare(b.DueAt)
+		case a.Overdue:
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	return report, nil
+}
+
+// verificationStatuses completa a situação de verificação dos planos
+// informados com o vencimento e o atraso em now.
+func verificationStatuses(ctx context.Context, repo port.VerificationRepository, backupPlanIDs []uuid.UUID, now time.Time) ([]domain.VerificationStatus, error) {
+	statuses, err := repo.ListVerificationStatuses(ctx, backupPlanIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, status := range statuses {
+		statuses[i] = status.Schedule.Status(status.LastVerifiedAt, now)
+		statuses[i].BackupPlanName = status.BackupPlanName
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"context"
+)
+
+type clientKey struct{}
+
+type client struct {
+	ip        string
+	userAgent string
+}
+
+// WithClient guarda no contexto o IP e o user agent de quem fez a requisição,
+// usados para registrar as sessões de login.
+func WithClient(ctx context.Context, ip, userAgent string) context.Context {
+	return context.WithValue(ctx, clientKey{}, client{ip, userAgent})
+}
+
+func Client(ctx context.Context) (ip, userAgent string) {
+	c, _ := ctx.Value(clientKey{}).(client)
+	return c.ip, c.userAgent
+}
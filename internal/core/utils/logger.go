@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"context"
+	"log/slog"
+)
+
+type loggerKey struct{}
+
+// WithLogger guarda no contexto um logger já enriquecido com os campos de
+// correlação da requisição (request_id, trace_id, rota, usuário).
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// Logger devolve o logger da requisição ou o logger padrão quando o
+// contexto não carrega nenhum.
+func Logger(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
@@ -1,38 +1,69 @@
 package utils
 
 import (
-	"fmt"
+	"reflect"
 	"strings"
 
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
 	"github.com/go-playground/validator/v10"
 )
 
-func TranslateValidationError(e validator.FieldError) string {
-	switch e.Tag() {
-	case "required":
-		return fmt.Sprintf("O campo '%s' é obrigatório", strings.ToLower(e.Field()))
-	case "email":
-		return fmt.Sprintf("O campo '%s' deve ser um email válido", strings.ToLower(e.Field()))
-	case "oneof":
-		return fmt.Sprintf("O campo '%s' deve ser um dos valores permitidos: %s", strings.ToLower(e.Field()), e.Param())
-	case "uuid4":
-		return fmt.Sprintf("O campo '%s' deve conter um UUID v4 válido", strings.ToLower(e.Field()))
-	case "min":
-		return fmt.Sprintf("O campo '%s' deve ter no mínimo '%s' caracteres", strings.ToLower(e.Field()), e.Param())
-	case "max":
-		return fmt.Sprintf("O campo '%s' deve ter no máximo '%s' caracteres", strings.ToLower(e.Field()), e.Param())
-	case "alphanum":
-		return fmt.Sprintf("O campo '%s' deve contém apenas caracteres alfanuméricos", strings.ToLower(e.Field()))
+// Tags cuja mensagem recebe o parâmetro da regra além do nome do campo.
+var validationTagsWithParam = map[string]bool{
+	"oneof":    true,
+	"min":      true,
+	"max":      true,
+	"len":      true,
+	"gte":      true,
+	"lte":      true,
+	"gt":       true,
+	"lt":       true,
+	"datetime": true,
+}
+
+// Tags de tamanho: o significado do parâmetro depende do tipo do campo
+// (caracteres em textos, itens em listas e o próprio valor em números).
+var validationSizeTags = map[string]bool{
+	"min": true,
+	"max": true,
+	"len": true,
+}
+
+func TranslateValidationError(lang i18n.Lang, e validator.FieldError) string {
+	field := strings.ToLower(e.Field())
+	key := "validation." + e.Tag()
+	if validationSizeTags[e.Tag()] {
+		key += sizeKeySuffix(e.Kind())
+	}
+
+	if !i18n.Has(key) {
+		return i18n.T(lang, "validation.default", field, e.Tag())
+	}
+
+	if validationTagsWithParam[e.Tag()] {
+		return i18n.T(lang, key, field, e.Param())
+	}
+	return i18n.T(lang, key, field)
+}
+
+func sizeKeySuffix(kind reflect.Kind) string {
+	switch kind {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return "_items"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "_number"
 	default:
-		return fmt.Sprintf("O campo '%s' é inválido (%s)", strings.ToLower(e.Field()), e.Tag())
+		return ""
 	}
 }
 
-func ValidationErrorsToMap(err error) map[string]string {
+func ValidationErrorsToMap(lang i18n.Lang, err error) map[string]string {
 	if errs, ok := err.(validator.ValidationErrors); ok {
 		errorsMap := make(map[string]string)
 		for _, e := range errs {
-			errorsMap[strings.ToLower(e.Field())] = TranslateValidationError(e)
+			errorsMap[strings.ToLower(e.Field())] = TranslateValidationError(lang, e)
 		}
 		return errorsMap
 	}
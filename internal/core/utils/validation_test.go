@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/GustavoPaula/go-backup-management-api/internal/core/i18n"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestValidationErrorsToMapSizeMessages(t *testing.T) {
+	type request struct {
+		Name         string   `json:"name" validate:"min=3"`
+		Sources      []string `json:"sources" validate:"min=1"`
+		IntervalDays int      `json:"interval_days" validate:"max=30"`
+		Code         *string  `json:"code" validate:"omitempty,len=6"`
+	}
+
+	code := "123"
+	err := validator.New().Struct(request{
+		Name:         "ab",
+		Sources:      []string{},
+		IntervalDays: 31,
+		Code:         &code,
+	})
+
+	tests := []struct {
+		field string
+		want  map[i18n.Lang]string
+	}{
+		{
+			field: "name",
+			want: map[i18n.Lang]string{
+				i18n.En:   "The field 'name' must have at least '3' characters",
+				i18n.PtBR: "O campo 'name' deve ter no mínimo '3' caracteres",
+			},
+		},
+		{
+			field: "sources",
+			want: map[i18n.Lang]string{
+				i18n.En:   "The field 'sources' must have at least '1' items",
+				i18n.PtBR: "O campo 'sources' deve ter no mínimo '1' itens",
+			},
+		},
+		{
+			field: "intervaldays",
+			want: map[i18n.Lang]string{
+				i18n.En:   "The field 'intervaldays' must be at most '30'",
+				i18n.PtBR: "O campo 'intervaldays' deve ser no máximo '30'",
+			},
+		},
+		{
+			field: "code",
+			want: map[i18n.Lang]string{
+				i18n.En:   "The field 'code' must have exactly '6' characters",
+				i18n.PtBR: "O campo 'code' deve ter exatamente '6' caracteres",
+			},
+		},
+	}
+
+	for _, lang := range []i18n.Lang{i18n.En, i18n.PtBR} {
+		got := ValidationErrorsToMap(lang, err)
+		for _, tt := range tests {
+			if got[tt.field] != tt.want[lang] {
+				t.Errorf("%s[%s] = %q, want %q", lang, tt.field, got[tt.field], tt.want[lang])
+			}
+		}
+	}
+}